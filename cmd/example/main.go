@@ -1,7 +1,8 @@
 // Command example demonstrates a complete interactive flow with the eero-go
 // client library. It implements:
 //
-//   - Local session caching via .eero_session.json (0600 permissions)
+//   - Local session caching via .eero_session.json (0600 permissions,
+//     through eero.FileSessionStore)
 //   - Strict context timeouts on every API call
 //   - Graceful fallback from cached session to interactive login
 //   - Tabwriter-formatted device listing with safe pointer dereferencing
@@ -10,7 +11,6 @@ package main
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -24,11 +24,6 @@ import (
 // sessionFile is the local path where the session token is cached.
 const sessionFile = ".eero_session.json"
 
-// sessionData is the JSON structure persisted to disk.
-type sessionData struct {
-	UserToken string `json:"user_token"`
-}
-
 func main() {
 	// Use a background context for the program execution.
 	// We avoid a short global timeout here because interactive login
@@ -42,13 +37,15 @@ func main() {
 
 func run(ctx context.Context) error {
 	// ── 1. Initialize the client ────────────────────────────────────────
-	client, err := eero.NewClient()
+	// The session store restores a cached token now and saves the new one
+	// after a successful verification.
+	client, err := eero.NewClient(eero.WithSessionStore(&eero.FileSessionStore{Path: sessionFile}))
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
 
-	// ── 2. Attempt to restore a cached session ──────────────────────────
-	if err := restoreSession(client); err != nil {
+	// ── 2. Check for a restored session ─────────────────────────────────
+	if _, ok := client.SessionCookie(); !ok {
 		// No cached session (or file unreadable) — fall through to login.
 		fmt.Println("No cached session found; starting interactive login.")
 		if err := interactiveLogin(ctx, client); err != nil {
@@ -57,16 +54,15 @@ func run(ctx context.Context) error {
 	} else {
 		// Validate the cached token by hitting a lightweight endpoint.
 		fmt.Println("Restored cached session. Validating…")
-		if _, err := client.Account.Get(ctx); err != nil {
+		valid, err := client.Auth.SessionValid(ctx)
+		if err != nil {
+			return fmt.Errorf("validating session: %w", err)
+		}
+		if !valid {
 			// Token was rejected (expired / revoked). Fall back to login.
-			var apiErr *eero.APIError
-			if errors.As(err, &apiErr) && apiErr.IsAuthError() {
-				fmt.Println("Cached session expired; re-authenticating.")
-				if err := interactiveLogin(ctx, client); err != nil {
-					return fmt.Errorf("login flow: %w", err)
-				}
-			} else {
-				return fmt.Errorf("validating session: %w", err)
+			fmt.Println("Cached session expired; re-authenticating.")
+			if err := interactiveLogin(ctx, client); err != nil {
+				return fmt.Errorf("login flow: %w", err)
 			}
 		} else {
 			fmt.Println("Session is valid.")
@@ -117,43 +113,6 @@ func run(ctx context.Context) error {
 	return nil
 }
 
-// ─── Session Management ─────────────────────────────────────────────────────
-
-// restoreSession reads the cached user_token from disk and injects it into the
-// client's cookie jar.
-func restoreSession(client *eero.Client) error {
-	data, err := os.ReadFile(sessionFile)
-	if err != nil {
-		return fmt.Errorf("reading session file: %w", err)
-	}
-
-	var sess sessionData
-	if err := json.Unmarshal(data, &sess); err != nil {
-		return fmt.Errorf("parsing session file: %w", err)
-	}
-	if sess.UserToken == "" {
-		return fmt.Errorf("session file contains empty token")
-	}
-
-	// Inject the token into the client's cookie jar so all subsequent
-	// requests carry the Cookie: s=<user_token> header.
-	return client.SetSessionCookie(sess.UserToken)
-}
-
-// saveSession writes the user_token to disk with strict 0600 permissions
-// so that only the file owner can read or modify it.
-func saveSession(userToken string) error {
-	sess := sessionData{UserToken: userToken}
-	data, err := json.MarshalIndent(sess, "", "  ")
-	if err != nil {
-		return fmt.Errorf("marshaling session: %w", err)
-	}
-	if err := os.WriteFile(sessionFile, data, 0600); err != nil {
-		return fmt.Errorf("writing session file: %w", err)
-	}
-	return nil
-}
-
 // ─── Interactive Login ──────────────────────────────────────────────────────
 
 // interactiveLogin drives the two-step email → verification-code flow,
@@ -169,8 +128,7 @@ func interactiveLogin(ctx context.Context, client *eero.Client) error {
 	}
 	identifier = strings.TrimSpace(identifier)
 
-	loginResp, err := client.Auth.Login(ctx, identifier)
-	if err != nil {
+	if _, err := client.Auth.Login(ctx, identifier); err != nil {
 		return fmt.Errorf("initiating login: %w", err)
 	}
 	fmt.Println("Verification code sent to your device.")
@@ -183,13 +141,14 @@ func interactiveLogin(ctx context.Context, client *eero.Client) error {
 	}
 	code = strings.TrimSpace(code)
 
-	if err := client.Auth.Verify(ctx, code); err != nil {
+	// Verify also persists the token through the session store, so the
+	// next run skips login.
+	err = client.Auth.Verify(ctx, code)
+	if err != nil && !errors.Is(err, eero.ErrSessionNotSaved) {
 		return fmt.Errorf("verifying code: %w", err)
 	}
 	fmt.Println("Authenticated successfully!")
-
-	// Persist the session token so we skip login next time.
-	if err := saveSession(loginResp.UserToken); err != nil {
+	if err != nil {
 		// Non-fatal — warn but continue.
 		fmt.Fprintf(os.Stderr, "warning: could not cache session: %v\n", err)
 	} else {
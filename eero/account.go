@@ -2,16 +2,33 @@ package eero
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
 	"time"
 )
 
+// AccountAPI is the method set of AccountService and the type of
+// Client.Account. See NetworkAPI for its use with fakes.
+type AccountAPI interface {
+	Get(ctx context.Context) (*Account, error)
+	Organization(ctx context.Context) (*Organization, error)
+	SetPushSettings(ctx context.Context, settings PushSettings) error
+	SetMarketingConsent(ctx context.Context, consented bool) error
+	TransferNetwork(ctx context.Context, networkURL, recipientEmail string) error
+	CancelTransfer(ctx context.Context, networkURL string) error
+}
+
 // AccountService provides access to the authenticated user's eero account.
 type AccountService struct {
 	client *Client
 }
 
+var _ AccountAPI = (*AccountService)(nil)
+
 // --- Response types ---
 
 // Account represents the authenticated user's eero account, including the
@@ -41,6 +58,11 @@ type Account struct {
 	EeroForBusiness           bool            `json:"eero_for_business"`
 	MduProgram                bool            `json:"mdu_program"`
 	BusinessDetails           any             `json:"business_details"`
+
+	// NotModified reports that the API answered 304 Not Modified and the
+	// account was decoded from the previous response (see
+	// WithConditionalRequests).
+	NotModified bool `json:"-"`
 }
 
 // AccountEmail holds email-related account fields.
@@ -90,14 +112,14 @@ type ReportIssue struct {
 
 // PremiumDetails holds eero Plus/Secure subscription information.
 type PremiumDetails struct {
-	TrialEnds            *time.Time `json:"trial_ends"`
-	HasPaymentInfo       bool       `json:"has_payment_info"`
-	Tier                 string     `json:"tier"`
-	SubscribedSince      *time.Time `json:"subscribed_since"`
-	IsIapCustomer        bool       `json:"is_iap_customer"`
-	PaymentMethod        string     `json:"payment_method"`
-	Interval             string     `json:"interval"`
-	NextBillingEventDate *time.Time `json:"next_billing_event_date"`
+	TrialEnds            *time.Time  `json:"trial_ends"`
+	HasPaymentInfo       bool        `json:"has_payment_info"`
+	Tier                 PremiumTier `json:"tier"`
+	SubscribedSince      *time.Time  `json:"subscribed_since"`
+	IsIapCustomer        bool        `json:"is_iap_customer"`
+	PaymentMethod        string      `json:"payment_method"`
+	Interval             string      `json:"interval"`
+	NextBillingEventDate *time.Time  `json:"next_billing_event_date"`
 }
 
 // PushSettings holds push notification preferences.
@@ -116,6 +138,16 @@ type MarketingEmailsConsent struct {
 	Consented bool `json:"consented"`
 }
 
+// Organization is an eero for Business organization and the networks it
+// manages, which the flat Account.Networks list does not group.
+type Organization struct {
+	// ID is the account's OrganizationID the organization was fetched by.
+	ID       string          `json:"-"`
+	URL      string          `json:"url"`
+	Name     string          `json:"name"`
+	Networks AccountNetworks `json:"networks"`
+}
+
 // --- Methods ---
 
 // Get retrieves the authenticated user's account information, including the
@@ -124,6 +156,9 @@ type MarketingEmailsConsent struct {
 // The returned Account.Networks.Data entries contain a URL field
 // (e.g., "/2.2/networks/12345") that can be passed directly to
 // NetworkService.Get and DeviceService.List.
+//
+// With WithCache, repeated calls within the TTL are served from memory; with
+// WithConditionalRequests, an unchanged account sets NotModified.
 func (s *AccountService) Get(ctx context.Context) (*Account, error) {
 	req, err := s.client.newRequest(ctx, "account", http.MethodGet, "/account", nil)
 	if err != nil {
@@ -131,9 +166,216 @@ func (s *AccountService) Get(ctx context.Context) (*Account, error) {
 	}
 
 	var resp EeroResponse[Account]
-	if err := s.client.doRaw(req, &resp); err != nil {
+	_, notModified, err := s.client.doCachedHeader(req, &resp)
+	if err != nil {
 		return nil, fmt.Errorf("account: %w", err)
 	}
+	resp.Data.NotModified = notModified
+
+	return &resp.Data, nil
+}
+
+// Organization returns the eero for Business organization the account belongs
+// to, with its networks. It first fetches the account to read
+// OrganizationID; accounts without one get an error wrapping
+// ErrNotBusinessAccount, and no organization request is sent.
+func (s *AccountService) Organization(ctx context.Context) (*Organization, error) {
+	account, err := s.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.OrganizationID == nil || *account.OrganizationID == "" {
+		return nil, fmt.Errorf("account: organization: %w", ErrNotBusinessAccount)
+	}
+	id := *account.OrganizationID
+
+	req, err := s.client.newRequest(ctx, "account", http.MethodGet, "/organizations/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[Organization]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("account: organization: %w", err)
+	}
+	resp.Data.ID = id
 
 	return &resp.Data, nil
 }
+
+// pushSettingsRequest is the body for updating push notification
+// preferences; it mirrors the "push_settings" object of the account.
+type pushSettingsRequest struct {
+	PushSettings PushSettings `json:"push_settings"`
+}
+
+// SetPushSettings replaces the account's push notification preferences
+// (Account.PushSettings), for example to silence node-offline alerts during
+// planned maintenance. Both fields are always sent, so read the current
+// settings with Get first to change only one of them.
+func (s *AccountService) SetPushSettings(ctx context.Context, settings PushSettings) error {
+	req, err := s.client.newRequest(ctx, "account", http.MethodPut, "/account", pushSettingsRequest{PushSettings: settings})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("account: set push settings: %w", err)
+	}
+
+	return nil
+}
+
+// consentsRequest is the body for updating consent preferences; it mirrors
+// the "consents" object of the account.
+type consentsRequest struct {
+	Consents Consents `json:"consents"`
+}
+
+// SetMarketingConsent records whether the account holder agrees to receive
+// marketing emails (Account.Consents.MarketingEmails.Consented).
+func (s *AccountService) SetMarketingConsent(ctx context.Context, consented bool) error {
+	body := consentsRequest{Consents: Consents{MarketingEmails: MarketingEmailsConsent{Consented: consented}}}
+	req, err := s.client.newRequest(ctx, "account", http.MethodPut, "/account", body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("account: set marketing consent: %w", err)
+	}
+
+	return nil
+}
+
+// transferRequest is the body for starting a network ownership transfer.
+type transferRequest struct {
+	Email string `json:"email"`
+}
+
+// TransferNetwork starts handing ownership of the specified network to the
+// eero account registered to recipientEmail, who must accept it in the eero
+// app. Only the owner can do this (see Account.IsOwner and CanTransfer); when
+// the API refuses with 403 the error wraps both ErrNotOwner and the
+// underlying *APIError. A malformed address is rejected with
+// ErrInvalidArgument before any request is sent.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/transfer" suffix is appended
+// automatically.
+func (s *AccountService) TransferNetwork(ctx context.Context, networkURL, recipientEmail string) error {
+	addr, err := mail.ParseAddress(recipientEmail)
+	if err != nil || addr.Name != "" || addr.Address != strings.TrimSpace(recipientEmail) {
+		return fmt.Errorf("account: transfer network: %w: invalid recipient email %q", ErrInvalidArgument, recipientEmail)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "account", http.MethodPost, networkURL+"/transfer", transferRequest{Email: addr.Address})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return transferError("transfer network", err)
+	}
+
+	return nil
+}
+
+// CancelTransfer withdraws a pending ownership transfer of the specified
+// network started with TransferNetwork. As there, a 403 yields an error
+// wrapping ErrNotOwner.
+func (s *AccountService) CancelTransfer(ctx context.Context, networkURL string) error {
+	req, err := s.client.newRequestFromURL(ctx, "account", http.MethodDelete, networkURL+"/transfer", nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return transferError("cancel transfer", err)
+	}
+
+	return nil
+}
+
+// transferError wraps err for op, adding ErrNotOwner when the API refused
+// the request as forbidden.
+func transferError(op string, err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.HTTPStatusCode == http.StatusForbidden || apiErr.Code == http.StatusForbidden) {
+		return fmt.Errorf("account: %s: %w: %w", op, ErrNotOwner, err)
+	}
+	return fmt.Errorf("account: %s: %w", op, err)
+}
+
+// DefaultNetworkURL returns the URL of the account's first network, which is
+// the only one for most households. This spares single-network tools the
+// account → network lookup before every call.
+//
+// The URL is fetched once and cached for the client's lifetime; the cache is
+// cleared when a new session is established via Login or SetSessionCookie.
+// If the account has no networks, an error wrapping ErrNoNetworks is
+// returned.
+func (c *Client) DefaultNetworkURL(ctx context.Context) (string, error) {
+	c.sessionMu.Lock()
+	cached, gen := c.defaultNetworkURL, c.sessionGen
+	c.sessionMu.Unlock()
+	if cached != "" {
+		return cached, nil
+	}
+
+	acct, err := c.Account.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(acct.Networks.Data) == 0 || acct.Networks.Data[0].URL == "" {
+		return "", fmt.Errorf("account: default network: %w", ErrNoNetworks)
+	}
+	networkURL := acct.Networks.Data[0].URL
+
+	c.sessionMu.Lock()
+	if c.sessionGen == gen {
+		c.defaultNetworkURL = networkURL
+	}
+	c.sessionMu.Unlock()
+
+	return networkURL, nil
+}
+
+// MaxClockSkew is the clock difference above which ClockSkew reports the
+// local clock as out of sync. Drift of this size can make TLS certificate checks
+// and session expiry behave unexpectedly.
+const MaxClockSkew = 2 * time.Minute
+
+// ClockSkew estimates how far the local clock is from the eero server's. It
+// issues a GET /account, the same probe SessionValid uses, parses
+// meta.server_time, and compares it with the local time halfway through the
+// exchange. A positive result means the server is ahead of the local clock.
+//
+// The estimate is only accurate to about a second, the resolution of
+// server_time. The exceeds result reports that the magnitude is above
+// MaxClockSkew; this is a warning, not a failure, and the skew is returned
+// either way. An error is returned if the response carries no server_time.
+func (c *Client) ClockSkew(ctx context.Context) (skew time.Duration, exceeds bool, err error) {
+	req, err := c.newRequest(ctx, "account", http.MethodGet, "/account", nil)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var resp struct {
+		Meta struct {
+			ServerTime EeroTime `json:"server_time"`
+		} `json:"meta"`
+	}
+	start := time.Now()
+	if err := c.doRaw(req, &resp); err != nil {
+		return 0, false, fmt.Errorf("account: clock skew: %w", err)
+	}
+	local := start.Add(time.Since(start) / 2)
+
+	if resp.Meta.ServerTime.IsZero() {
+		return 0, false, errors.New("account: clock skew: response has no server_time")
+	}
+
+	skew = resp.Meta.ServerTime.Sub(local)
+	return skew, skew > MaxClockSkew || skew < -MaxClockSkew, nil
+}
@@ -2,8 +2,12 @@ package eero_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -131,3 +135,415 @@ func TestAccountService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountService_SetPushSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		settings   eero.PushSettings
+		expectBody string
+	}{
+		{
+			name:       "DisableNodeOffline",
+			settings:   eero.PushSettings{NetworkOffline: true, NodeOffline: false},
+			expectBody: `{"push_settings":{"networkOffline":true,"nodeOffline":false}}`,
+		},
+		{
+			name:       "EnableBoth",
+			settings:   eero.PushSettings{NetworkOffline: true, NodeOffline: true},
+			expectBody: `{"push_settings":{"networkOffline":true,"nodeOffline":true}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, body)
+				}
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			if err := client.Account.SetPushSettings(ctx, tc.settings); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccountService_SetMarketingConsent(t *testing.T) {
+	t.Parallel()
+
+	for _, consented := range []bool{true, false} {
+		consented := consented
+		t.Run(fmt.Sprint(consented), func(t *testing.T) {
+			t.Parallel()
+
+			want := fmt.Sprintf(`{"consents":{"marketing_emails":{"consented":%t}}}`, consented)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != want {
+					t.Errorf("Expected body %s, got %s", want, body)
+				}
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			if err := client.Account.SetMarketingConsent(ctx, consented); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccountService_Organization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		account     string
+		wantErr     error
+		wantOrgCall bool
+	}{
+		{
+			name:        "Business",
+			account:     `{"organization_id": "org-7", "eero_for_business": true}`,
+			wantOrgCall: true,
+		},
+		{
+			name:    "Consumer_NullOrganization",
+			account: `{"organization_id": null}`,
+			wantErr: eero.ErrNotBusinessAccount,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var orgCalls int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": ` + tc.account + `}`))
+			})
+			mux.HandleFunc("/2.2/organizations/org-7", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&orgCalls, 1)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {
+					"url": "/2.2/organizations/org-7",
+					"name": "Acme Cafes",
+					"networks": {"count": 2, "data": [
+						{"url": "/2.2/networks/1", "name": "Downtown"},
+						{"url": "/2.2/networks/2", "name": "Airport"}
+					]}
+				}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			org, err := client.Account.Organization(ctx)
+			if n := atomic.LoadInt32(&orgCalls); (n > 0) != tc.wantOrgCall {
+				t.Errorf("Expected organization request = %v, got %d calls", tc.wantOrgCall, n)
+			}
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if org.ID != "org-7" || org.Name != "Acme Cafes" || len(org.Networks.Data) != 2 || org.Networks.Data[1].Name != "Airport" {
+				t.Errorf("Unexpected organization: %+v", org)
+			}
+		})
+	}
+}
+
+func TestAccountService_TransferNetwork(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		email       string
+		mockStatus  int
+		expectBody  string
+		wantErr     error
+		wantNoCalls bool
+	}{
+		{
+			name:       "Success",
+			email:      "new.owner@example.com",
+			mockStatus: http.StatusOK,
+			expectBody: `{"email":"new.owner@example.com"}`,
+		},
+		{
+			name:       "Failure_NotOwner",
+			email:      "new.owner@example.com",
+			mockStatus: http.StatusForbidden,
+			expectBody: `{"email":"new.owner@example.com"}`,
+			wantErr:    eero.ErrNotOwner,
+		},
+		{
+			name:        "Failure_InvalidEmail",
+			email:       "not-an-email",
+			wantErr:     eero.ErrInvalidArgument,
+			wantNoCalls: true,
+		},
+		{
+			name:        "Failure_DisplayName",
+			email:       "Bob <bob@example.com>",
+			wantErr:     eero.ErrInvalidArgument,
+			wantNoCalls: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/transfer", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, body)
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Account.TransferNetwork(ctx, "/2.2/networks/44444", tc.email)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Expected %v, got %v", tc.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := atomic.LoadInt32(&calls); tc.wantNoCalls && n != 0 {
+				t.Errorf("Expected no request, got %d", n)
+			}
+		})
+	}
+}
+
+func TestAccountService_CancelTransfer(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444/transfer", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := client.Account.CancelTransfer(ctx, "/2.2/networks/44444"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestClient_DefaultNetworkURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		networksJSON string
+		expectURL    string
+		wantErr      bool
+	}{
+		{
+			name:         "Success_SingleNetwork",
+			networksJSON: `{"count": 1, "data": [{"url": "/2.2/networks/111", "name": "Home"}]}`,
+			expectURL:    "/2.2/networks/111",
+		},
+		{
+			name:         "Success_MultiNetworkFirstChosen",
+			networksJSON: `{"count": 2, "data": [{"url": "/2.2/networks/111", "name": "Home"}, {"url": "/2.2/networks/222", "name": "Cabin"}]}`,
+			expectURL:    "/2.2/networks/111",
+		},
+		{
+			name:         "Failure_ZeroNetworks",
+			networksJSON: `{"count": 0, "data": []}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Test User", "networks": ` + tc.networksJSON + `}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			got, err := client.DefaultNetworkURL(ctx)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("DefaultNetworkURL() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				if !errors.Is(err, eero.ErrNoNetworks) {
+					t.Errorf("Expected ErrNoNetworks, got %v", err)
+				}
+				return
+			}
+			if got != tc.expectURL {
+				t.Errorf("Expected %s, got %s", tc.expectURL, got)
+			}
+
+			// A second call is served from the cache.
+			if _, err := client.DefaultNetworkURL(ctx); err != nil {
+				t.Fatalf("Unexpected error on cached call: %v", err)
+			}
+			if n := atomic.LoadInt32(&hits); n != 1 {
+				t.Errorf("Expected 1 account fetch, got %d", n)
+			}
+
+			// Re-authenticating invalidates the cache.
+			if err := client.SetSessionCookie("new_session_token"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if _, err := client.DefaultNetworkURL(ctx); err != nil {
+				t.Fatalf("Unexpected error after re-auth: %v", err)
+			}
+			if n := atomic.LoadInt32(&hits); n != 2 {
+				t.Errorf("Expected 2 account fetches after re-auth, got %d", n)
+			}
+		})
+	}
+}
+
+func TestClient_ClockSkew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		offset      time.Duration
+		omitTime    bool
+		wantExceeds bool
+		wantErr     bool
+	}{
+		{name: "ServerAhead", offset: 10 * time.Minute, wantExceeds: true},
+		{name: "ServerBehind", offset: -90 * time.Second},
+		{name: "InSync", offset: 0},
+		{name: "Failure_NoServerTime", omitTime: true, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/account" {
+					t.Errorf("Expected /account, got %s", r.URL.Path)
+				}
+				meta := `{"code": 200}`
+				if !tc.omitTime {
+					serverTime := time.Now().Add(tc.offset).UTC().Format("2006-01-02T15:04:05+0000")
+					meta = `{"code": 200, "server_time": "` + serverTime + `"}`
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": ` + meta + `, "data": {}}`))
+			}))
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			skew, exceeds, err := client.ClockSkew(ctx)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ClockSkew() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			// server_time has one-second resolution.
+			if diff := skew - tc.offset; diff < -2*time.Second || diff > 2*time.Second {
+				t.Errorf("ClockSkew() = %s, want about %s", skew, tc.offset)
+			}
+			if exceeds != tc.wantExceeds {
+				t.Errorf("ClockSkew() exceeds = %v, want %v (skew %s)", exceeds, tc.wantExceeds, skew)
+			}
+		})
+	}
+}
@@ -2,9 +2,19 @@ package eero
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 )
 
+// AuthAPI is the method set of AuthService and the type of Client.Auth. See
+// NetworkAPI for its use with fakes.
+type AuthAPI interface {
+	Login(ctx context.Context, identifier string) (*LoginResponse, error)
+	Verify(ctx context.Context, verificationCode string) error
+	SessionValid(ctx context.Context) (bool, error)
+}
+
 // AuthService handles authentication against the eero API.
 // The login flow is a two-step process:
 //  1. Login sends an identifier (email or phone) and receives a user_token.
@@ -14,6 +24,8 @@ type AuthService struct {
 	client *Client
 }
 
+var _ AuthAPI = (*AuthService)(nil)
+
 // --- Request / Response types ---
 
 // LoginRequest is the body sent to POST /login.
@@ -37,6 +49,9 @@ type VerifyRequest struct {
 // phone number. Eero will send a verification code to the provided identifier.
 // The returned user_token is automatically stored on the client and set as the
 // session cookie for subsequent requests.
+//
+// Accounts that must sign in with Amazon get an *APIError that matches
+// ErrAmazonLoginRequired via errors.Is instead.
 func (s *AuthService) Login(ctx context.Context, identifier string) (*LoginResponse, error) {
 	body := LoginRequest{Login: identifier}
 
@@ -62,7 +77,9 @@ func (s *AuthService) Login(ctx context.Context, identifier string) (*LoginRespo
 // Verify completes the two-step authentication by sending the verification
 // code that was delivered to the user's email or phone. After a successful
 // verification, the session cookie is fully activated and all subsequent API
-// calls will be authenticated.
+// calls will be authenticated. With WithSessionStore, the verified token is
+// then saved to the store; if that fails, the returned error wraps
+// ErrSessionNotSaved and the session is still active.
 func (s *AuthService) Verify(ctx context.Context, verificationCode string) error {
 	body := VerifyRequest{Code: verificationCode}
 
@@ -71,5 +88,51 @@ func (s *AuthService) Verify(ctx context.Context, verificationCode string) error
 		return err
 	}
 
-	return s.client.do(req, nil)
+	if err := s.client.do(req, nil); err != nil {
+		return err
+	}
+
+	if err := s.client.saveSession(); err != nil {
+		return fmt.Errorf("auth: saving session: %w: %w", ErrSessionNotSaved, err)
+	}
+	return nil
+}
+
+// SessionValid reports whether the session cookie currently held by the
+// client is accepted by the eero API. It issues a GET /account, since the
+// API offers no smaller authenticated endpoint, so the network cost is the
+// same as AccountService.Get: the full account body is downloaded. Only the
+// decoding of the account into a struct is skipped.
+//
+// It returns (true, nil) when the session is accepted and (false, nil) when
+// the API rejects it with 401 or 403, meaning the caller should log in again.
+// Any other failure (network errors, 5xx responses) is returned as an error so
+// that callers can distinguish "session expired" from "try again later".
+//
+// Cookies sent back by the server are only stored when the session is
+// accepted; a rejected check never mutates the client's cookie jar.
+func (s *AuthService) SessionValid(ctx context.Context) (bool, error) {
+	var jar *deferredJar
+	if s.client.HTTPClient.Jar != nil {
+		jar = &deferredJar{CookieJar: s.client.HTTPClient.Jar}
+		ctx = context.WithValue(ctx, jarOverrideKey{}, http.CookieJar(jar))
+	}
+
+	req, err := s.client.newRequest(ctx, "auth", http.MethodGet, "/account", nil)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.client.do(req, nil); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && (apiErr.IsAuthError() || apiErr.HTTPStatusCode == http.StatusForbidden || apiErr.Code == http.StatusForbidden) {
+			return false, nil
+		}
+		return false, fmt.Errorf("auth: session check: %w", err)
+	}
+
+	if jar != nil {
+		jar.commit()
+	}
+	return true, nil
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ func TestAuthService_Login(t *testing.T) {
 		// Expectations
 		wantErr         bool
 		isAuthErr       bool
+		isAmazonErr     bool
 		expectUserToken string
 	}{
 		{
@@ -59,6 +61,17 @@ func TestAuthService_Login(t *testing.T) {
 			wantErr:   true,
 			isAuthErr: true, // Should trigger apiErr.IsAuthError() == true
 		},
+		{
+			name:       "Failure_AmazonLoginRequired",
+			identifier: "linked@example.com",
+			mockStatus: http.StatusForbidden,
+			mockResponse: `{
+				"meta": {"code": 403, "error": "This account must use Amazon login"},
+				"data": {}
+			}`,
+			wantErr:     true,
+			isAmazonErr: true,
+		},
 		{
 			name:         "Failure_MalformedJSON",
 			identifier:   "test@example.com",
@@ -133,6 +146,9 @@ func TestAuthService_Login(t *testing.T) {
 						t.Errorf("Expected API error to report IsAuthError = true")
 					}
 				}
+				if got := errors.Is(err, eero.ErrAmazonLoginRequired); got != tc.isAmazonErr {
+					t.Errorf("errors.Is(err, ErrAmazonLoginRequired) = %v, want %v (err: %v)", got, tc.isAmazonErr, err)
+				}
 			} else {
 				if err != nil {
 					t.Fatalf("Expected no error, got: %v", err)
@@ -220,3 +236,92 @@ func TestAuthService_Verify(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_SessionValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockStatus   int
+		mockResponse string
+		wantValid    bool
+		wantErr      bool
+	}{
+		{
+			name:         "Success_SessionAccepted",
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {"name": "Test User"}}`,
+			wantValid:    true,
+		},
+		{
+			name:         "Expired_Unauthorized",
+			mockStatus:   http.StatusUnauthorized,
+			mockResponse: `{"meta": {"code": 401, "error": "error.session.invalid"}, "data": {}}`,
+			wantValid:    false,
+		},
+		{
+			name:         "Expired_Forbidden",
+			mockStatus:   http.StatusForbidden,
+			mockResponse: `{"meta": {"code": 403, "error": "error.session.refresh"}, "data": {}}`,
+			wantValid:    false,
+		},
+		{
+			name:         "Failure_ServerError",
+			mockStatus:   http.StatusServiceUnavailable,
+			mockResponse: `{"meta": {"code": 503, "error": "Service Unavailable"}, "data": {}}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET, got %s", r.Method)
+				}
+				if c, err := r.Cookie("s"); err != nil || c.Value != "test_session_active" {
+					t.Errorf("Expected session cookie to be sent, got %v (err %v)", c, err)
+				}
+				if tc.mockStatus != http.StatusOK {
+					// A rejected session must not be able to clobber the jar.
+					http.SetCookie(w, &http.Cookie{Name: "s", Value: "server_cleared"})
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL
+
+			testURL, _ := url.Parse(client.BaseURL)
+			client.HTTPClient.Jar.SetCookies(testURL, []*http.Cookie{
+				{Name: "s", Value: "test_session_active"},
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			valid, err := client.Auth.SessionValid(ctx)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SessionValid() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if valid != tc.wantValid {
+				t.Errorf("SessionValid() = %v, want %v", valid, tc.wantValid)
+			}
+
+			for _, c := range client.HTTPClient.Jar.Cookies(testURL) {
+				if c.Name == "s" && c.Value != "test_session_active" {
+					t.Errorf("Expected jar to keep original session cookie, got %q", c.Value)
+				}
+			}
+		})
+	}
+}
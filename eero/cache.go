@@ -0,0 +1,239 @@
+package eero
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithCache keeps successful responses to Account.Get and Network.Get in
+// memory for ttl, so repeated reads within that window are answered without
+// a request. Entries are keyed by endpoint URL and the cache is safe for
+// concurrent use.
+//
+// Any successful mutation sent through the client (a POST, PUT, PATCH or
+// DELETE) empties the cache, as does setting a new session cookie. Changes
+// made elsewhere, such as in the eero app, are only seen once an entry
+// expires; call Client.InvalidateCache or use WithCacheBypass to read fresh
+// data sooner. Polling helpers such as Network.RebootAndWait, and the checks
+// that guard mutations (Network.UpdateStatus and so StartUpdate,
+// CreateForward, Reservation.Create), always bypass the cache.
+func WithCache(ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return fmt.Errorf("WithCache: ttl must be positive, got %s", ttl)
+		}
+		c.cache = newResponseCache(ttl)
+		return nil
+	}
+}
+
+// InvalidateCache discards every cached response (see WithCache). It is a
+// no-op on a client without a cache.
+func (c *Client) InvalidateCache() {
+	if c.cache != nil {
+		c.cache.invalidate()
+	}
+}
+
+// invalidateCacheAfter empties the cache after a successful mutation, which
+// may have changed anything a cached GET returned.
+func (c *Client) invalidateCacheAfter(req *http.Request) {
+	if c.cache != nil && req.Method != http.MethodGet && req.Method != http.MethodHead {
+		c.cache.invalidate()
+	}
+}
+
+// WithConditionalRequests makes Account.Get and Network.Get remember the
+// ETag and body of each endpoint's last response and send the ETag back in
+// If-None-Match. When the API answers 304 Not Modified, the remembered body
+// is decoded again and the result's NotModified field is set, so frequent
+// polls of rarely changing resources transfer almost nothing.
+//
+// ETags are discarded when a new session cookie is set, or explicitly with
+// Client.ClearETags.
+func WithConditionalRequests() Option {
+	return func(c *Client) error {
+		c.etags = &etagStore{entries: make(map[string]etagEntry)}
+		return nil
+	}
+}
+
+// ClearETags discards every remembered ETag (see WithConditionalRequests), so
+// the next request to each endpoint is unconditional. It is a no-op on a
+// client without conditional requests.
+func (c *Client) ClearETags() {
+	if c.etags != nil {
+		c.etags.clear()
+	}
+}
+
+// etagStore remembers the last ETag and body seen for each endpoint URL.
+type etagStore struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+// etagEntry is the last validated response for one endpoint.
+type etagEntry struct {
+	etag   string
+	body   []byte
+	header http.Header
+}
+
+func (es *etagStore) get(key string) (etagEntry, bool) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	e, ok := es.entries[key]
+	return e, ok
+}
+
+func (es *etagStore) put(key string, e etagEntry) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.entries[key] = e
+}
+
+func (es *etagStore) clear() {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	clear(es.entries)
+}
+
+// cacheBypassKey is the context key under which WithCacheBypass marks a call.
+type cacheBypassKey struct{}
+
+// WithCacheBypass returns a copy of ctx whose calls skip the response cache
+// (see WithCache) and always go to the API. The fresh response still
+// replaces the cached entry, so later cached reads see it too.
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+// responseCache holds raw response bodies keyed by request URL.
+type responseCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	// gen is bumped by invalidate so that a response fetched before an
+	// invalidation is not stored after it.
+	gen uint64
+}
+
+// cacheEntry is one cached response.
+type cacheEntry struct {
+	body    []byte
+	header  http.Header
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+// get returns the unexpired entry for key, if any, along with the current
+// generation to pass to put.
+func (rc *responseCache) get(key string) (cacheEntry, uint64, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	e, ok := rc.entries[key]
+	if ok && !time.Now().Before(e.expires) {
+		delete(rc.entries, key)
+		ok = false
+	}
+	return e, rc.gen, ok
+}
+
+// put stores a response unless the cache was invalidated since gen.
+func (rc *responseCache) put(key string, gen uint64, body []byte, header http.Header) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if gen != rc.gen {
+		return
+	}
+	rc.entries[key] = cacheEntry{body: body, header: header, expires: time.Now().Add(rc.ttl)}
+}
+
+// invalidate empties the cache.
+func (rc *responseCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.entries)
+	rc.gen++
+}
+
+// doCachedHeader is like doRawHeader but, for GET requests, serves the
+// response from the TTL cache (see WithCache) when possible and otherwise
+// revalidates it with If-None-Match (see WithConditionalRequests). The bool
+// result reports that the API answered 304 Not Modified.
+func (c *Client) doCachedHeader(req *http.Request, v any) (http.Header, bool, error) {
+	if req.Method != http.MethodGet || (c.cache == nil && c.etags == nil) {
+		header, err := c.doRawHeader(req, v)
+		return header, false, err
+	}
+
+	key := req.URL.String()
+	var gen uint64
+	if c.cache != nil {
+		var entry cacheEntry
+		var hit bool
+		entry, gen, hit = c.cache.get(key)
+		if bypass, _ := req.Context().Value(cacheBypassKey{}).(bool); hit && !bypass {
+			return entry.header.Clone(), false, decodeCached(entry.body, v)
+		}
+	}
+
+	var prev etagEntry
+	var hasPrev bool
+	if c.etags != nil {
+		if prev, hasPrev = c.etags.get(key); hasPrev && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", prev.etag)
+		}
+	}
+
+	bodyBytes, _, header, err := c.performRequestAndCheck(req)
+	var apiErr *APIError
+	if hasPrev && errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusNotModified {
+		if c.cache != nil {
+			c.cache.put(key, gen, prev.body, prev.header)
+		}
+		return prev.header.Clone(), true, decodeCached(prev.body, v)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if v != nil && len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, v); err != nil {
+			return nil, false, fmt.Errorf("eero: decoding response: %w", err)
+		}
+		if c.debugDump != nil {
+			c.dumpResponse(req, v)
+		}
+	}
+	if len(bodyBytes) > 0 {
+		if c.cache != nil {
+			c.cache.put(key, gen, bodyBytes, header.Clone())
+		}
+		if etag := header.Get("ETag"); c.etags != nil && etag != "" {
+			c.etags.put(key, etagEntry{etag: etag, body: bodyBytes, header: header.Clone()})
+		}
+	}
+	return header, false, nil
+}
+
+// decodeCached decodes a stored response body into v.
+func decodeCached(body []byte, v any) error {
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("eero: decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestWithCache_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{name: "Success_Valid", ttl: time.Minute},
+		{name: "Failure_Zero", ttl: 0, wantErr: true},
+		{name: "Failure_Negative", ttl: -time.Second, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := eero.NewClient(eero.WithCache(tc.ttl))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// newCacheServer serves a network whose name changes on every GET, so tests
+// can tell a cached response from a fresh one, and counts GETs by path.
+func newCacheServer(t *testing.T) (*httptest.Server, *int32, *int32) {
+	t.Helper()
+	var accountHits, networkHits int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&accountHits, 1)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Jane"}}`))
+	})
+	mux.HandleFunc("/2.2/networks/123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			return
+		}
+		n := atomic.AddInt32(&networkHits, 1)
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, n))
+		_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"name": "Home %d"}}`, n)
+	})
+	mux.HandleFunc("/2.2/networks/123/reboot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &accountHits, &networkHits
+}
+
+func newCachingClient(t *testing.T, server *httptest.Server, ttl time.Duration) *eero.Client {
+	t.Helper()
+	client, err := eero.NewClient(eero.WithCache(ttl))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+	return client
+}
+
+func TestWithCache_ServesRepeatedGets(t *testing.T) {
+	t.Parallel()
+
+	server, accountHits, networkHits := newCacheServer(t)
+	client := newCachingClient(t, server, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		details, err := client.Network.Get(ctx, "/2.2/networks/123")
+		if err != nil {
+			t.Fatalf("Network.Get() error = %v", err)
+		}
+		if details.Name != "Home 1" || details.ETag != `"v1"` {
+			t.Errorf("Network.Get() #%d = %q (ETag %s), want cached \"Home 1\" (ETag \"v1\")", i, details.Name, details.ETag)
+		}
+		if _, err := client.Account.Get(ctx); err != nil {
+			t.Fatalf("Account.Get() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(networkHits); got != 1 {
+		t.Errorf("Expected 1 network request, got %d", got)
+	}
+	if got := atomic.LoadInt32(accountHits); got != 1 {
+		t.Errorf("Expected 1 account request, got %d", got)
+	}
+}
+
+func TestWithCache_Expires(t *testing.T) {
+	t.Parallel()
+
+	server, _, networkHits := newCacheServer(t)
+	client := newCachingClient(t, server, 20*time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := client.Network.Get(ctx, "/2.2/networks/123"); err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	time.Sleep(40 * time.Millisecond)
+	details, err := client.Network.Get(ctx, "/2.2/networks/123")
+	if err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	if details.Name != "Home 2" {
+		t.Errorf("Network.Get() after TTL = %q, want \"Home 2\"", details.Name)
+	}
+	if got := atomic.LoadInt32(networkHits); got != 2 {
+		t.Errorf("Expected 2 network requests, got %d", got)
+	}
+}
+
+func TestWithCache_Invalidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		invalidate func(ctx context.Context, client *eero.Client) error
+	}{
+		{
+			name: "InvalidateCache",
+			invalidate: func(ctx context.Context, client *eero.Client) error {
+				client.InvalidateCache()
+				return nil
+			},
+		},
+		{
+			name: "Mutation",
+			invalidate: func(ctx context.Context, client *eero.Client) error {
+				return client.Network.SetName(ctx, "/2.2/networks/123", "Cabin")
+			},
+		},
+		{
+			name: "EmptyBodyMutation",
+			invalidate: func(ctx context.Context, client *eero.Client) error {
+				return client.Network.Reboot(ctx, "/2.2/networks/123")
+			},
+		},
+		{
+			name: "NewSession",
+			invalidate: func(ctx context.Context, client *eero.Client) error {
+				return client.SetSessionCookie("new_token")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _, networkHits := newCacheServer(t)
+			client := newCachingClient(t, server, time.Minute)
+			ctx := context.Background()
+
+			if _, err := client.Network.Get(ctx, "/2.2/networks/123"); err != nil {
+				t.Fatalf("Network.Get() error = %v", err)
+			}
+			if err := tc.invalidate(ctx, client); err != nil {
+				t.Fatalf("invalidate error = %v", err)
+			}
+			details, err := client.Network.Get(ctx, "/2.2/networks/123")
+			if err != nil {
+				t.Fatalf("Network.Get() error = %v", err)
+			}
+			if details.Name != "Home 2" {
+				t.Errorf("Network.Get() after invalidation = %q, want \"Home 2\"", details.Name)
+			}
+			if got := atomic.LoadInt32(networkHits); got != 2 {
+				t.Errorf("Expected 2 network requests, got %d", got)
+			}
+		})
+	}
+}
+
+func TestWithCacheBypass(t *testing.T) {
+	t.Parallel()
+
+	server, _, networkHits := newCacheServer(t)
+	client := newCachingClient(t, server, time.Minute)
+	ctx := context.Background()
+
+	if _, err := client.Network.Get(ctx, "/2.2/networks/123"); err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	details, err := client.Network.Get(eero.WithCacheBypass(ctx), "/2.2/networks/123")
+	if err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	if details.Name != "Home 2" {
+		t.Errorf("Network.Get() with bypass = %q, want \"Home 2\"", details.Name)
+	}
+
+	// The bypassed response refreshes the cache for later reads.
+	details, err = client.Network.Get(ctx, "/2.2/networks/123")
+	if err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	if details.Name != "Home 2" {
+		t.Errorf("Network.Get() after bypass = %q, want cached \"Home 2\"", details.Name)
+	}
+	if got := atomic.LoadInt32(networkHits); got != 2 {
+		t.Errorf("Expected 2 network requests, got %d", got)
+	}
+}
+
+func TestWithCache_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	server, _, _ := newCacheServer(t)
+	client := newCachingClient(t, server, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%5 == 0 {
+				client.InvalidateCache()
+			}
+			if _, err := client.Network.Get(context.Background(), "/2.2/networks/123"); err != nil {
+				t.Errorf("Network.Get() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestWithConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// between runs after the first Get; wantNotModified is the second
+		// Get's NotModified.
+		between         func(client *eero.Client) error
+		wantNotModified bool
+		wantINM         string
+	}{
+		{
+			name:            "Success_NotModified",
+			between:         func(client *eero.Client) error { return nil },
+			wantNotModified: true,
+			wantINM:         `"v1"`,
+		},
+		{
+			name:    "ClearETags",
+			between: func(client *eero.Client) error { client.ClearETags(); return nil },
+		},
+		{
+			name:    "NewSession",
+			between: func(client *eero.Client) error { return client.SetSessionCookie("new_token") },
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+			var lastINM atomic.Value
+			server := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				inm := r.Header.Get("If-None-Match")
+				lastINM.Store(inm)
+				w.Header().Set("ETag", `"v1"`)
+				if inm == `"v1"` {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home"}}`))
+			})
+			defer server.Close()
+
+			client, err := eero.NewClient(eero.WithConditionalRequests())
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+			ctx := context.Background()
+
+			first, err := client.Network.Get(ctx, "/2.2/networks/123")
+			if err != nil {
+				t.Fatalf("Network.Get() error = %v", err)
+			}
+			if first.NotModified || lastINM.Load() != "" {
+				t.Fatalf("First Network.Get() NotModified = %t, If-None-Match = %q; want unconditional", first.NotModified, lastINM.Load())
+			}
+			if err := tc.between(client); err != nil {
+				t.Fatalf("between error = %v", err)
+			}
+
+			second, err := client.Network.Get(ctx, "/2.2/networks/123")
+			if err != nil {
+				t.Fatalf("Network.Get() error = %v", err)
+			}
+			if second.NotModified != tc.wantNotModified {
+				t.Errorf("NotModified = %t, want %t", second.NotModified, tc.wantNotModified)
+			}
+			if got := lastINM.Load(); got != tc.wantINM {
+				t.Errorf("If-None-Match = %q, want %q", got, tc.wantINM)
+			}
+			if second.Name != "Home" || second.ETag != `"v1"` {
+				t.Errorf("Network.Get() = %q (ETag %s), want \"Home\" (ETag \"v1\")", second.Name, second.ETag)
+			}
+			if got := atomic.LoadInt32(&hits); got != 2 {
+				t.Errorf("Expected 2 requests, got %d", got)
+			}
+		})
+	}
+}
+
+func TestWithConditionalRequests_Account(t *testing.T) {
+	t.Parallel()
+
+	server := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"a1"`)
+		if r.Header.Get("If-None-Match") == `"a1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Jane"}}`))
+	})
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithConditionalRequests())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+
+	for i, want := range []bool{false, true, true} {
+		acct, err := client.Account.Get(context.Background())
+		if err != nil {
+			t.Fatalf("Account.Get() #%d error = %v", i, err)
+		}
+		if acct.Name != "Jane" || acct.NotModified != want {
+			t.Errorf("Account.Get() #%d = %q, NotModified %t; want \"Jane\", %t", i, acct.Name, acct.NotModified, want)
+		}
+	}
+}
+
+func TestWithConditionalRequests_Unsolicited304(t *testing.T) {
+	t.Parallel()
+
+	// Without a remembered ETag a 304 cannot be satisfied and stays an error.
+	server := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithConditionalRequests())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+
+	_, err = client.Network.Get(context.Background(), "/2.2/networks/123")
+	var apiErr *eero.APIError
+	if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != http.StatusNotModified {
+		t.Fatalf("Network.Get() error = %v, want *APIError with status 304", err)
+	}
+}
+
+func TestWithCache_StartUpdateRefetches(t *testing.T) {
+	t.Parallel()
+
+	// The first GET reports no update available; the second one does. A
+	// StartUpdate that trusted the cached details would refuse to update.
+	var gets, posts int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/123", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&gets, 1)
+		_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"updates": {"has_update": true, "can_update_now": %t}}}`, n > 1)
+	})
+	mux.HandleFunc("/2.2/networks/123/updates", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := newCachingClient(t, server, time.Minute)
+	ctx := context.Background()
+
+	if _, err := client.Network.Get(ctx, "/2.2/networks/123"); err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+	if err := client.Network.StartUpdate(ctx, "/2.2/networks/123"); err != nil {
+		t.Fatalf("StartUpdate() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&gets); got != 2 {
+		t.Errorf("Expected StartUpdate to make a fresh GET (2 total), got %d", got)
+	}
+	if got := atomic.LoadInt32(&posts); got != 1 {
+		t.Errorf("Expected 1 POST, got %d", got)
+	}
+}
@@ -3,7 +3,9 @@ package eero
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -19,6 +21,9 @@ const (
 
 	// DefaultUserAgent mimics the eero iOS app.
 	DefaultUserAgent = "eero/3.0 (iPhone; iOS 17.0)"
+
+	// DefaultCookieName is the name of the eero session cookie.
+	DefaultCookieName = "s"
 )
 
 // Client is the top-level eero API client. It holds the HTTP client (with a
@@ -33,15 +38,19 @@ type Client struct {
 	// BaseURL is the root URL for all API requests.
 	BaseURL string
 
-	// UserAgent is the User-Agent header sent with every request.
+	// UserAgent is the User-Agent header sent with every request. The
+	// library suffix (see WithUserAgentSuffix) is appended to it.
 	UserAgent string
 
-	// Services — each service hangs off the client.
-	Auth    *AuthService
-	Account *AccountService
-	Network *NetworkService
-	Device  *DeviceService
-	Profile *ProfileService
+	// Services — each service hangs off the client. The fields hold the
+	// concrete *XService types by default and may be replaced with fakes.
+	Auth        AuthAPI
+	Account     AccountAPI
+	Network     NetworkAPI
+	Device      DeviceAPI
+	Profile     ProfileAPI
+	Guest       GuestNetworkAPI
+	Reservation ReservationAPI
 
 	// originMu protects cachedOriginURL and originURLSnapshot
 	originMu sync.RWMutex
@@ -53,12 +62,92 @@ type Client struct {
 	// originURLSnapshot stores the BaseURL string that cachedOriginURL was
 	// derived from. If BaseURL changes, we invalidate the cache.
 	originURLSnapshot string
+
+	// headers are additional headers attached to every request (see
+	// WithAppHeaders). They never override headers already on the request.
+	headers http.Header
+
+	// retry controls automatic retries of transient failures (see WithRetry).
+	retry retryConfig
+
+	// sessionMu protects the per-session state below: defaultNetworkURL,
+	// sessionGen, sessionToken and sessionExpires.
+	sessionMu sync.Mutex
+
+	// defaultNetworkURL caches the result of DefaultNetworkURL. It is
+	// cleared whenever a new session cookie is set.
+	defaultNetworkURL string
+
+	// sessionGen is bumped on every SetSessionCookie so that a
+	// DefaultNetworkURL lookup racing a re-auth does not cache a URL from
+	// the previous session.
+	sessionGen uint64
+
+	// sessionToken and sessionExpires record the token and expiry last
+	// given to SetSessionCookieWithExpiry, since the cookie jar does not
+	// report expiry back. A zero sessionExpires means a session cookie.
+	sessionToken   string
+	sessionExpires time.Time
+
+	// userAgentSuffix is appended to UserAgent, separated by a space, when
+	// non-empty (see WithUserAgentSuffix).
+	userAgentSuffix string
+
+	// forceJSONContentType sends "Content-Type: application/json" on
+	// bodiless POST/PUT/PATCH requests (see WithForceJSONContentType).
+	forceJSONContentType bool
+
+	// debugDump, when non-nil, receives a redacted dump of every decoded
+	// response (see WithDebugDump). debugMu serializes writes to it.
+	debugDump io.Writer
+	debugMu   sync.Mutex
+
+	// pausedProfilesMu protects pausedProfiles.
+	pausedProfilesMu sync.Mutex
+
+	// pausedProfiles records, per network URL, the profiles that
+	// NetworkService.PauseAll paused through its per-profile fallback, so
+	// that ResumeAll unpauses exactly those.
+	pausedProfiles map[string][]string
+
+	// requireDeadline rejects requests whose context has no deadline (see
+	// WithRequireContextDeadline).
+	requireDeadline bool
+
+	// logger, when non-nil, is called after every request (see WithLogger).
+	logger func(ctx context.Context, method, url string, status int, dur time.Duration)
+
+	// limiter, when non-nil, throttles every attempt (see WithRateLimit).
+	limiter *tokenBucket
+
+	// sessionStore, when non-nil, is loaded by NewClient and saved after a
+	// successful Verify (see WithSessionStore).
+	sessionStore SessionStore
+
+	// cookieName is the name of the session cookie (see WithCookieName).
+	cookieName string
+
+	// headerAuth sends the session token in the X-User-Token header instead
+	// of storing it in the cookie jar (see WithHeaderAuth).
+	headerAuth bool
+
+	// cache, when non-nil, holds responses to idempotent GETs (see
+	// WithCache).
+	cache *responseCache
+
+	// etags, when non-nil, remembers each endpoint's last ETag and body for
+	// If-None-Match revalidation (see WithConditionalRequests).
+	etags *etagStore
 }
 
+// userTokenHeader carries the session token when WithHeaderAuth is set.
+const userTokenHeader = "X-User-Token"
+
 // NewClient creates a new eero API client with sensible defaults.
 // The returned client uses a cookie jar for transparent session management
 // and is secured against resource leaks and open-redirect cookie theft.
-func NewClient() (*Client, error) {
+// Options are applied in order after the defaults have been set.
+func NewClient(opts ...Option) (*Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, fmt.Errorf("eero: creating cookie jar: %w", err)
@@ -98,13 +187,27 @@ func NewClient() (*Client, error) {
 		HTTPClient: httpClient,
 		BaseURL:    DefaultBaseURL,
 		UserAgent:  DefaultUserAgent,
+
+		userAgentSuffix: defaultUserAgentSuffix,
+		cookieName:      DefaultCookieName,
+	}
+
+	// Apply every option and report all invalid ones at once rather than
+	// making the caller fix them one at a time.
+	var errs []error
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("eero: invalid client option: %w", errors.Join(errs...))
 	}
 
-	// Initialize the origin URL cache for the default BaseURL.
-	// We ignore errors here because DefaultBaseURL is a constant known to be valid.
-	if u, err := url.Parse(DefaultBaseURL); err == nil {
+	// Compute the origin URL cache once, now that BaseURL is final.
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Scheme != "" && u.Host != "" {
 		c.cachedOriginURL = &url.URL{Scheme: u.Scheme, Host: u.Host}
-		c.originURLSnapshot = DefaultBaseURL
+		c.originURLSnapshot = c.BaseURL
 	}
 
 	c.Auth = &AuthService{client: c}
@@ -112,28 +215,130 @@ func NewClient() (*Client, error) {
 	c.Network = &NetworkService{client: c}
 	c.Device = &DeviceService{client: c}
 	c.Profile = &ProfileService{client: c}
+	c.Guest = &GuestNetworkService{client: c}
+	c.Reservation = &ReservationService{client: c}
+
+	if c.sessionStore != nil {
+		if err := c.restoreSession(); err != nil {
+			return nil, err
+		}
+	}
 
 	return c, nil
 }
 
 // SetSessionCookie programmatically sets the eero session cookie on the
-// client's cookie jar. This is useful when restoring a previously obtained
+// client's cookie jar (or, with WithHeaderAuth, the token sent in the
+// X-User-Token header). This is useful when restoring a previously obtained
 // user_token without going through the full login flow. The underlying
 // cookiejar executes safely across concurrent Goroutines.
+//
+// The token is checked with ValidateSessionToken before it is stored, so an
+// empty or corrupted token is rejected instead of silently producing
+// unauthenticated requests.
 func (c *Client) SetSessionCookie(userToken string) error {
+	return c.SetSessionCookieWithExpiry(userToken, time.Time{})
+}
+
+// SetSessionCookieWithExpiry is like SetSessionCookie but gives the cookie
+// an expiration time, after which the jar drops it and requests go out
+// unauthenticated. Long-lived daemons can restore a cookie saved from
+// SessionCookie this way and re-authenticate before it lapses. A zero
+// expires sets a session cookie, exactly like SetSessionCookie; one already
+// in the past returns an error wrapping ErrInvalidArgument.
+func (c *Client) SetSessionCookieWithExpiry(userToken string, expires time.Time) error {
+	if err := ValidateSessionToken(userToken); err != nil {
+		return err
+	}
+	if !expires.IsZero() && !expires.After(time.Now()) {
+		return fmt.Errorf("eero: session cookie: %w: expiry %s is in the past", ErrInvalidArgument, expires.Format(time.RFC3339))
+	}
+	if !c.headerAuth {
+		u, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return fmt.Errorf("eero: parsing base URL: %w", err)
+		}
+		c.HTTPClient.Jar.SetCookies(u, []*http.Cookie{
+			{
+				Name:     c.cookieName,
+				Value:    userToken,
+				Expires:  expires,
+				Secure:   true, // Enforce transit over HTTPS
+				HttpOnly: true, // Prevent client-side script access
+			},
+		})
+	}
+
+	c.InvalidateCache()
+	c.ClearETags()
+
+	c.sessionMu.Lock()
+	c.defaultNetworkURL = ""
+	c.sessionGen++
+	c.sessionToken = userToken
+	c.sessionExpires = expires
+	c.sessionMu.Unlock()
+	return nil
+}
+
+// SessionCookie returns the session cookie ("s" unless changed with
+// WithCookieName) the client's jar currently holds for BaseURL, or false if there is none.
+// With WithHeaderAuth it instead reports the token sent in X-User-Token,
+// until its expiry. The jar only reports names
+// and values, so Expires is filled in from the last SetSessionCookieWithExpiry
+// call and left zero when unknown (a session cookie, or a token the server
+// has since rotated). The result can be serialized and later restored with
+// SetSessionCookieWithExpiry.
+func (c *Client) SessionCookie() (*http.Cookie, bool) {
+	if c.headerAuth {
+		token, expires, ok := c.headerToken()
+		if !ok {
+			return nil, false
+		}
+		return &http.Cookie{Name: c.cookieName, Value: token, Expires: expires, Secure: true, HttpOnly: true}, true
+	}
+	if c.HTTPClient.Jar == nil {
+		return nil, false
+	}
 	u, err := url.Parse(c.BaseURL)
 	if err != nil {
-		return fmt.Errorf("eero: parsing base URL: %w", err)
-	}
-	c.HTTPClient.Jar.SetCookies(u, []*http.Cookie{
-		{
-			Name:     "s",
-			Value:    userToken,
-			Secure:   true, // Enforce transit over HTTPS
-			HttpOnly: true, // Prevent client-side script access
-		},
-	})
-	return nil
+		return nil, false
+	}
+	for _, ck := range c.HTTPClient.Jar.Cookies(u) {
+		if ck.Name != c.cookieName {
+			continue
+		}
+		cookie := &http.Cookie{Name: c.cookieName, Value: ck.Value, Secure: true, HttpOnly: true}
+		c.sessionMu.Lock()
+		if ck.Value == c.sessionToken {
+			cookie.Expires = c.sessionExpires
+		}
+		c.sessionMu.Unlock()
+		return cookie, true
+	}
+	return nil, false
+}
+
+// CloseIdleConnections closes any keep-alive connections the client's
+// transport is holding idle, without interrupting requests in flight. Call it
+// after a burst of requests before a long pause (for example a daemon that
+// polls hourly) rather than waiting for the idle timeout. A custom transport
+// is asked to do the same only if it has a CloseIdleConnections method;
+// otherwise this is a no-op.
+func (c *Client) CloseIdleConnections() {
+	c.HTTPClient.CloseIdleConnections()
+}
+
+// headerToken returns the token to send with WithHeaderAuth, honoring the
+// expiry the jar would otherwise enforce.
+func (c *Client) headerToken() (token string, expires time.Time, ok bool) {
+	c.sessionMu.Lock()
+	token, expires = c.sessionToken, c.sessionExpires
+	c.sessionMu.Unlock()
+	if token == "" || (!expires.IsZero() && !time.Now().Before(expires)) {
+		return "", time.Time{}, false
+	}
+	return token, expires, true
 }
 
 // newRequest creates an *http.Request with the appropriate headers and
@@ -154,54 +359,326 @@ type EeroResponse[T any] struct {
 	Data T        `json:"data"`
 }
 
-// performRequest executes the HTTP request and reads the response body up to a limit.
-func (c *Client) performRequest(req *http.Request) ([]byte, int, error) {
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, 0, fmt.Errorf("eero: executing request: %w", err)
+// Meta is the complete "meta" object of an eero response, keyed by field
+// name. Besides code and error it may carry server hints such as
+// server_time or pagination cursors; values are left undecoded.
+type Meta map[string]json.RawMessage
+
+// jarOverrideKey is the context key under which a request-scoped
+// http.CookieJar may be stored. When present, it replaces the client's jar for
+// that single exchange (see httpClientFor).
+type jarOverrideKey struct{}
+
+// ifMatchKey is the context key under which WithIfMatch stores an ETag.
+type ifMatchKey struct{}
+
+// WithIfMatch returns a copy of ctx that makes mutations (PUT, POST, PATCH,
+// DELETE) issued with it conditional on the resource still having the given
+// ETag, typically NetworkDetails.ETag from a prior Get. If another client has
+// changed the resource in the meantime the API answers 412 and the method
+// returns an error matching ErrConflict. An empty etag leaves ctx unchanged.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	if etag == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// RequestIDHeader is the header carrying each request's correlation ID.
+const RequestIDHeader = "X-Request-Id"
+
+// requestIDKey is the context key under which WithRequestID stores an ID.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx whose requests carry id in the
+// X-Request-Id header, so they can be matched against your own logs or
+// quoted to eero support. Requests made without one get a random UUID. In
+// both cases the WithLogger hook receives a context from which
+// RequestIDFromContext recovers the ID that was sent. An empty id leaves ctx
+// unchanged.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored by WithRequestID, if
+// any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// newRequestID returns a random RFC 4122 version 4 UUID, or "" if the
+// system's random source fails.
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// requestTimeoutKey is the context key under which WithRequestTimeout stores
+// a per-call timeout.
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a copy of ctx whose requests use d instead of
+// the client-level fallback timeout (HTTPClient.Timeout, 30 seconds by
+// default, see WithTimeout). Use it for a single slow call, such as polling
+// a speed test, without loosening the limit for every other call:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+//	defer cancel()
+//	speed, err := client.Network.GetSpeedTest(eero.WithRequestTimeout(ctx, 2*time.Minute), job.URL)
+//
+// The override applies to each HTTP attempt; the context's own deadline
+// still bounds the whole call, including retries, so whichever is shorter
+// wins. Raising the fallback therefore needs a context deadline at least as
+// long. A zero d removes the fallback entirely, leaving only the context
+// deadline; a negative d leaves ctx unchanged.
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	if d < 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, requestTimeoutKey{}, d)
+}
+
+// httpClientFor returns the *http.Client that should execute a request
+// carrying ctx. In the common case this is c.HTTPClient itself; when the
+// context carries a jar override or a WithRequestTimeout override, a shallow
+// copy sharing the same transport and redirect policy is returned with the
+// jar or timeout swapped out.
+func (c *Client) httpClientFor(ctx context.Context) *http.Client {
+	jar, hasJar := ctx.Value(jarOverrideKey{}).(http.CookieJar)
+	timeout, hasTimeout := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	if !hasJar && !hasTimeout {
+		return c.HTTPClient
+	}
+	hc := *c.HTTPClient
+	if hasJar {
+		hc.Jar = jar
+	}
+	if hasTimeout {
+		hc.Timeout = timeout
+	}
+	return &hc
+}
+
+// deferredJar reads cookies from an underlying jar but buffers any cookies
+// the server tries to set until commit is called. It lets a caller decide,
+// after inspecting the response, whether the exchange may mutate the session.
+type deferredJar struct {
+	http.CookieJar
+
+	mu      sync.Mutex
+	pending []deferredCookies
+}
+
+type deferredCookies struct {
+	u       *url.URL
+	cookies []*http.Cookie
+}
+
+// SetCookies implements http.CookieJar by buffering instead of storing.
+func (j *deferredJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.pending = append(j.pending, deferredCookies{u: u, cookies: cookies})
+}
+
+// commit flushes all buffered cookies into the underlying jar.
+func (j *deferredJar) commit() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, p := range j.pending {
+		j.CookieJar.SetCookies(p.u, p.cookies)
 	}
-	defer func() { _ = resp.Body.Close() }()
+	j.pending = nil
+}
 
-	// SECURITY: Limit payloads to 5MB to prevent memory exhaustion / DoS attacks.
-	const maxBodyBytes = 5 * 1024 * 1024
-	bodyReader := io.LimitReader(resp.Body, maxBodyBytes)
+// performRequest executes the HTTP request and reads the response body up to a
+// limit. When retries are enabled (see WithRetry), transient failures are
+// retried according to the client's retry configuration. The returned
+// response's body has already been consumed; only its status and headers
+// remain meaningful.
+//
+// When the caller's context is cancelled or its deadline passes at any
+// stage (rate-limit wait, exchange, body read or retry backoff), the error
+// wraps ctx.Err(), so errors.Is(err, context.Canceled) or
+// errors.Is(err, context.DeadlineExceeded) tells it apart from an *APIError.
+// Other failures to get a response are returned as a *TransportError.
+func (c *Client) performRequest(req *http.Request) (body []byte, resp *http.Response, err error) {
+	if c.logger != nil {
+		start := time.Now()
+		defer func() {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			ctx := req.Context()
+			if _, ok := RequestIDFromContext(ctx); !ok {
+				ctx = WithRequestID(ctx, req.Header.Get(RequestIDHeader))
+			}
+			c.logger(ctx, req.Method, redactURL(req.URL), status, time.Since(start))
+		}()
+	}
+
+	attempts := c.retry.attemptsFor(req)
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(req.Context()); err != nil {
+				return nil, nil, fmt.Errorf("eero: waiting for rate limiter: %w", err)
+			}
+		}
+		body, resp, err := c.performAttempt(req)
+		if attempt >= attempts || !c.retry.shouldRetry(req.Context(), resp, err) {
+			if err != nil {
+				return nil, nil, err
+			}
+			return body, resp, nil
+		}
+
+		if err := sleepContext(req.Context(), c.retry.backoff(attempt, resp)); err != nil {
+			return nil, nil, fmt.Errorf("eero: waiting to retry: %w", err)
+		}
+		if req, err = rewindRequest(req); err != nil {
+			return nil, nil, fmt.Errorf("eero: rewinding request body: %w", err)
+		}
+	}
+}
 
-	bodyBytes, err := io.ReadAll(bodyReader)
+// maxDrainWait bounds how long a response body keeps draining in the
+// background after the caller's context is cancelled mid-read.
+const maxDrainWait = time.Second
+
+// performAttempt executes a single HTTP exchange and reads the response body
+// up to a limit. The returned response's body has already been consumed and
+// closed; only its status and headers remain meaningful.
+//
+// The exchange runs on a context detached from the caller's cancellation.
+// While waiting for response headers a cancellation is forwarded at once,
+// but once the body is streaming it only stops the read: the remainder is
+// drained in the background (for at most maxDrainWait) so the connection can
+// return to the idle pool instead of being torn down. Either way the caller
+// gets an error wrapping ctx.Err().
+func (c *Client) performAttempt(req *http.Request) ([]byte, *http.Response, error) {
+	ctx := req.Context()
+	tctx, tcancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, tcancel)
+
+	resp, err := c.httpClientFor(ctx).Do(req.WithContext(tctx))
+	stopped := stop()
 	if err != nil {
-		return nil, resp.StatusCode, fmt.Errorf("eero: reading response body: %w", err)
+		tcancel()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, fmt.Errorf("eero: executing request: %w", ctxErr)
+		}
+		return nil, nil, &TransportError{Op: "executing request", Method: req.Method, URL: redactURL(req.URL), Err: err}
+	}
+	if !stopped {
+		// Cancelled between the headers arriving and the body read starting.
+		_ = resp.Body.Close()
+		tcancel()
+		return nil, nil, fmt.Errorf("eero: executing request: %w", ctx.Err())
+	}
+
+	readBody := func() ([]byte, error) {
+		defer tcancel()
+		defer func() { _ = resp.Body.Close() }()
+		return io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	}
+
+	if ctx.Done() == nil {
+		// The caller's context can never be cancelled; read inline.
+		bodyBytes, err := readBody()
+		if err != nil {
+			return nil, nil, &TransportError{Op: "reading response body", Method: req.Method, URL: redactURL(req.URL), Err: err}
+		}
+		return bodyBytes, resp, nil
+	}
+
+	type readResult struct {
+		body []byte
+		err  error
+	}
+	done := make(chan readResult, 1)
+	go func() {
+		bodyBytes, err := readBody()
+		done <- readResult{bodyBytes, err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			return nil, nil, &TransportError{Op: "reading response body", Method: req.Method, URL: redactURL(req.URL), Err: r.err}
+		}
+		return r.body, resp, nil
+	case <-ctx.Done():
+		// Leave the goroutine draining the body, but give up on the
+		// connection if the server does not finish promptly.
+		time.AfterFunc(maxDrainWait, tcancel)
+		return nil, nil, fmt.Errorf("eero: reading response body: %w", ctx.Err())
 	}
-	return bodyBytes, resp.StatusCode, nil
 }
 
+// maxBodyBytes caps every response body read.
+// SECURITY: Limit payloads to 5MB to prevent memory exhaustion / DoS attacks.
+const maxBodyBytes = 5 * 1024 * 1024
+
+// maxRawMetaBytes caps the meta object kept in APIError.Raw.
+const maxRawMetaBytes = 16 * 1024
+
 // performRequestAndCheck executes the request, reads the body, and performs
 // error checking against the "meta" envelope. It returns the raw body bytes
-// and the "data" segment if successful.
-func (c *Client) performRequestAndCheck(req *http.Request) ([]byte, json.RawMessage, error) {
-	bodyBytes, statusCode, err := c.performRequest(req)
+// and the "data" segment if successful, along with the response headers.
+func (c *Client) performRequestAndCheck(req *http.Request) ([]byte, json.RawMessage, http.Header, error) {
+	bodyBytes, resp, err := c.performRequest(req)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+	statusCode := resp.StatusCode
+
+	// Some mutation endpoints answer 202 Accepted (or 204) with no body at
+	// all. Treat an empty successful response as carrying no data.
+	if statusCode >= 200 && statusCode < 300 && len(bytes.TrimSpace(bodyBytes)) == 0 {
+		c.invalidateCacheAfter(req)
+		return nil, nil, resp.Header, nil
 	}
 
 	var combined struct {
-		Meta APIError        `json:"meta"`
+		Meta json.RawMessage `json:"meta"`
 		Data json.RawMessage `json:"data"`
 	}
+	var meta APIError
 
-	if err := json.Unmarshal(bodyBytes, &combined); err != nil {
-		return nil, nil, &APIError{
+	err = json.Unmarshal(bodyBytes, &combined)
+	if err == nil && len(combined.Meta) > 0 {
+		err = json.Unmarshal(combined.Meta, &meta)
+	}
+	if err != nil {
+		return nil, nil, nil, &APIError{
 			HTTPStatusCode: statusCode,
 			Code:           statusCode,
 			Message:        fmt.Sprintf("unparseable response body (%d bytes)", len(bodyBytes)),
+			RetryAfter:     retryAfterOf(resp),
 		}
 	}
 
-	if statusCode < 200 || statusCode >= 300 || combined.Meta.Code >= 400 {
-		apiErr := &combined.Meta
-		apiErr.HTTPStatusCode = statusCode
-		return nil, nil, apiErr
+	if statusCode < 200 || statusCode >= 300 || meta.Code >= 400 {
+		meta.HTTPStatusCode = statusCode
+		meta.RetryAfter = retryAfterOf(resp)
+		if len(combined.Meta) <= maxRawMetaBytes {
+			meta.Raw = combined.Meta
+		}
+		return nil, nil, nil, &meta
 	}
 
-	return bodyBytes, combined.Data, nil
+	c.invalidateCacheAfter(req)
+	return bodyBytes, combined.Data, resp.Header, nil
 }
 
 // do executes the given request and decodes the JSON envelope. If the API
@@ -209,7 +686,7 @@ func (c *Client) performRequestAndCheck(req *http.Request) ([]byte, json.RawMess
 // *APIError is returned. If v is non-nil, the "data" portion of the response
 // envelope is decoded into it.
 func (c *Client) do(req *http.Request, v any) error {
-	_, data, err := c.performRequestAndCheck(req)
+	_, data, _, err := c.performRequestAndCheck(req)
 	if err != nil {
 		return err
 	}
@@ -221,31 +698,83 @@ func (c *Client) do(req *http.Request, v any) error {
 			if err := json.Unmarshal(data, v); err != nil {
 				return fmt.Errorf("eero: decoding response data: %w", err)
 			}
+			if c.debugDump != nil {
+				c.dumpResponse(req, v)
+			}
 		}
 	}
 
 	return nil
 }
 
+// Do sends a request to an endpoint this package does not wrap yet, such as
+// diagnostics or Thread settings. relativeURL is resolved against the API
+// origin exactly like the URLs the services take (e.g.
+// "/2.2/networks/12345/thread"), so it is subject to the same host and
+// scheme guard, and the request carries the session cookie and the client's
+// headers, retries, and rate limit.
+//
+// body, if non-nil, is sent as JSON. If out is non-nil, the full response
+// envelope — both "meta" and "data", as with EeroResponse[T] — is decoded
+// into it; a typical out is *EeroResponse[json.RawMessage] or a pointer to
+// an EeroResponse of a caller-defined type. Non-2xx responses and error
+// meta codes are returned as *APIError, as from any other method.
+func (c *Client) Do(ctx context.Context, method, relativeURL string, body, out any) error {
+	req, err := c.newRequestFromURL(ctx, "eero", method, relativeURL, body)
+	if err != nil {
+		return err
+	}
+
+	if err := c.doRaw(req, out); err != nil {
+		return fmt.Errorf("eero: %s %s: %w", method, relativeURL, err)
+	}
+	return nil
+}
+
+// Fetch is the typed counterpart of Client.Do: it sends the request through
+// client and returns the response's "data" decoded as *T. It applies the
+// same origin guard, session cookie, and *APIError mapping as the built-in
+// service methods. Use Client.Do instead when the "meta" object is needed.
+//
+//	type threadInfo struct{ Enabled bool `json:"enabled"` }
+//	info, err := eero.Fetch[threadInfo](ctx, client, http.MethodGet, networkURL+"/thread", nil)
+func Fetch[T any](ctx context.Context, client *Client, method, relativeURL string, body any) (*T, error) {
+	var resp EeroResponse[T]
+	if err := client.Do(ctx, method, relativeURL, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // doRaw executes the given request and unmarshals the entire JSON response
 // body into v. Unlike do(), this method does not separate the "meta" and
 // "data" fields — it is intended for use with EeroResponse[T] where the
 // caller controls the full envelope type. Error checking is performed by
 // inspecting the HTTP status and parsing a meta envelope from the raw bytes.
 func (c *Client) doRaw(req *http.Request, v any) error {
-	bodyBytes, _, err := c.performRequestAndCheck(req)
+	_, err := c.doRawHeader(req, v)
+	return err
+}
+
+// doRawHeader is like doRaw but also returns the response headers, for
+// callers that need values such as the ETag.
+func (c *Client) doRawHeader(req *http.Request, v any) (http.Header, error) {
+	bodyBytes, _, header, err := c.performRequestAndCheck(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Unmarshal the full response into the caller's target.
-	if v != nil {
+	if v != nil && len(bodyBytes) > 0 {
 		if err := json.Unmarshal(bodyBytes, v); err != nil {
-			return fmt.Errorf("eero: decoding response: %w", err)
+			return nil, fmt.Errorf("eero: decoding response: %w", err)
+		}
+		if c.debugDump != nil {
+			c.dumpResponse(req, v)
 		}
 	}
 
-	return nil
+	return header, nil
 }
 
 // originURL returns the scheme+host portion of BaseURL (e.g.,
@@ -327,7 +856,32 @@ func (c *Client) newRequestFromURL(ctx context.Context, serviceName, method, rel
 	return c.buildRequest(ctx, serviceName, method, uStr, body)
 }
 
+// userAgent returns the full User-Agent header value: UserAgent followed by
+// the library suffix, if any.
+func (c *Client) userAgent() string {
+	if c.userAgentSuffix == "" {
+		return c.UserAgent
+	}
+	return c.UserAgent + " " + c.userAgentSuffix
+}
+
+// isMutation reports whether method is one that conventionally carries a
+// request body.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	}
+	return false
+}
+
 func (c *Client) buildRequest(ctx context.Context, serviceName, method, urlStr string, body any) (*http.Request, error) {
+	if c.requireDeadline {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, fmt.Errorf("%s: %w", serviceName, ErrNoDeadline)
+		}
+	}
+
 	var bodyReader io.Reader
 	if body != nil {
 		buf, err := json.Marshal(body)
@@ -342,10 +896,30 @@ func (c *Client) buildRequest(ctx context.Context, serviceName, method, urlStr s
 		return nil, fmt.Errorf("%s: creating request: %w", serviceName, err)
 	}
 
-	req.Header.Set("User-Agent", c.UserAgent)
-	if body != nil {
+	req.Header.Set("User-Agent", c.userAgent())
+	if body != nil || (c.forceJSONContentType && isMutation(method)) {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, values := range c.headers {
+		if req.Header.Get(key) == "" && len(values) > 0 {
+			req.Header.Set(key, values[0])
+		}
+	}
+	if etag, ok := ctx.Value(ifMatchKey{}).(string); ok && method != http.MethodGet && method != http.MethodHead {
+		req.Header.Set("If-Match", etag)
+	}
+	id, ok := RequestIDFromContext(ctx)
+	if !ok {
+		id = newRequestID()
+	}
+	if id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+	if c.headerAuth {
+		if token, _, ok := c.headerToken(); ok {
+			req.Header.Set(userTokenHeader, token)
+		}
+	}
 
 	return req, nil
 }
@@ -1,8 +1,13 @@
 package eero_test
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/arvarik/eero-go/eero"
 )
@@ -78,3 +83,139 @@ func TestSetSessionCookie_InvalidBaseURL(t *testing.T) {
 		t.Error("Expected error due to invalid BaseURL, got nil")
 	}
 }
+
+func TestSetSessionCookieWithExpiry(t *testing.T) {
+	client, err := eero.NewClient()
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	if _, ok := client.SessionCookie(); ok {
+		t.Fatal("Expected no session cookie on a fresh client")
+	}
+
+	expires := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+	if err := client.SetSessionCookieWithExpiry("token-with-expiry", expires); err != nil {
+		t.Fatalf("SetSessionCookieWithExpiry() error = %v", err)
+	}
+
+	cookie, ok := client.SessionCookie()
+	if !ok {
+		t.Fatal("Expected a session cookie after SetSessionCookieWithExpiry")
+	}
+	if cookie.Name != "s" || cookie.Value != "token-with-expiry" {
+		t.Errorf("Unexpected cookie %s=%s", cookie.Name, cookie.Value)
+	}
+	if !cookie.Expires.Equal(expires) {
+		t.Errorf("Expected expiry %s, got %s", expires, cookie.Expires)
+	}
+	if !cookie.Secure || !cookie.HttpOnly {
+		t.Errorf("Expected Secure and HttpOnly, got %+v", cookie)
+	}
+
+	// A plain SetSessionCookie replaces the expiry with a session cookie.
+	if err := client.SetSessionCookie("plain-token"); err != nil {
+		t.Fatalf("SetSessionCookie() error = %v", err)
+	}
+	cookie, ok = client.SessionCookie()
+	if !ok || cookie.Value != "plain-token" || !cookie.Expires.IsZero() {
+		t.Errorf("Expected plain-token with no expiry, got %+v (ok=%v)", cookie, ok)
+	}
+
+	err = client.SetSessionCookieWithExpiry("stale-token", time.Now().Add(-time.Hour))
+	if !errors.Is(err, eero.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for a past expiry, got %v", err)
+	}
+	if cookie, _ := client.SessionCookie(); cookie == nil || cookie.Value != "plain-token" {
+		t.Errorf("Expected a rejected expiry to leave the cookie unchanged, got %+v", cookie)
+	}
+}
+
+func TestWithCookieName(t *testing.T) {
+	client, err := eero.NewClient(eero.WithCookieName("sid"))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	if err := client.SetSessionCookie("custom-name-token"); err != nil {
+		t.Fatalf("SetSessionCookie() error = %v", err)
+	}
+
+	u, _ := url.Parse(client.BaseURL)
+	var names []string
+	for _, cookie := range client.HTTPClient.Jar.Cookies(u) {
+		names = append(names, cookie.Name)
+	}
+	if len(names) != 1 || names[0] != "sid" {
+		t.Errorf("Expected only cookie \"sid\" in the jar, got %v", names)
+	}
+
+	cookie, ok := client.SessionCookie()
+	if !ok || cookie.Name != "sid" || cookie.Value != "custom-name-token" {
+		t.Errorf("Expected sid=custom-name-token, got %+v (ok=%v)", cookie, ok)
+	}
+
+	for _, name := range []string{"", "bad name", "a;b", "s=1"} {
+		if _, err := eero.NewClient(eero.WithCookieName(name)); err == nil {
+			t.Errorf("Expected an error for cookie name %q", name)
+		}
+	}
+}
+
+func TestWithHeaderAuth(t *testing.T) {
+	var gotToken, gotCookie string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/login", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "header-token"}}`))
+	})
+	mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-User-Token")
+		gotCookie = r.Header.Get("Cookie")
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithHeaderAuth(), eero.WithBaseURL(server.URL+"/2.2"))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.Auth.Login(ctx, "user@example.com"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := client.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get() error = %v", err)
+	}
+	if gotToken != "header-token" {
+		t.Errorf("Expected X-User-Token header-token, got %q", gotToken)
+	}
+	if gotCookie != "" {
+		t.Errorf("Expected no Cookie header, got %q", gotCookie)
+	}
+
+	u, _ := url.Parse(client.BaseURL)
+	if cookies := client.HTTPClient.Jar.Cookies(u); len(cookies) != 0 {
+		t.Errorf("Expected an empty jar, got %v", cookies)
+	}
+	if cookie, ok := client.SessionCookie(); !ok || cookie.Value != "header-token" {
+		t.Errorf("Expected SessionCookie to report the header token, got %+v (ok=%v)", cookie, ok)
+	}
+
+	// An expired token is no longer sent.
+	if err := client.SetSessionCookieWithExpiry("short-lived", time.Now().Add(50*time.Millisecond)); err != nil {
+		t.Fatalf("SetSessionCookieWithExpiry() error = %v", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get() error = %v", err)
+	}
+	if gotToken != "" {
+		t.Errorf("Expected no X-User-Token after expiry, got %q", gotToken)
+	}
+	if _, ok := client.SessionCookie(); ok {
+		t.Error("Expected SessionCookie to report no session after expiry")
+	}
+}
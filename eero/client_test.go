@@ -3,8 +3,12 @@ package eero_test
 import (
 	"context"
 	"errors"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httptrace"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -168,3 +172,300 @@ func TestErrorHandling(t *testing.T) {
 		t.Errorf("Expected error message 'Internal Server Error', got '%s'", apiErr.Message)
 	}
 }
+
+// TestContextErrors ensures a cancelled or expired context surfaces as
+// context.Canceled / context.DeadlineExceeded rather than an API error.
+func TestContextErrors(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer server.Close()
+	defer close(release)
+
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr error
+	}{
+		{
+			name: "AlreadyCancelled",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "CancelledWhileWaiting",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(20*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "DeadlineWhileWaiting",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 20*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL
+
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			_, err := client.Account.Get(ctx)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Expected %v, got %v", tc.wantErr, err)
+			}
+			var apiErr *eero.APIError
+			if errors.As(err, &apiErr) {
+				t.Errorf("Expected no *APIError for a context error, got %v", apiErr)
+			}
+		})
+	}
+}
+
+// TestWithRequestTimeout ensures a per-call timeout overrides the client's
+// fallback timeout in both directions without affecting other calls.
+func TestWithRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	server := setupMockServer(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"status": "success"}}`))
+	})
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		clientLimit time.Duration
+		override    func(context.Context) context.Context
+		wantTimeout bool
+	}{
+		{name: "ClientFallbackApplies", clientLimit: 30 * time.Millisecond, wantTimeout: true},
+		{
+			name:        "OverrideExtends",
+			clientLimit: 30 * time.Millisecond,
+			override:    func(ctx context.Context) context.Context { return eero.WithRequestTimeout(ctx, time.Second) },
+		},
+		{
+			name:        "OverrideDisables",
+			clientLimit: 30 * time.Millisecond,
+			override:    func(ctx context.Context) context.Context { return eero.WithRequestTimeout(ctx, 0) },
+		},
+		{
+			name:        "OverrideShortens",
+			clientLimit: time.Second,
+			override:    func(ctx context.Context) context.Context { return eero.WithRequestTimeout(ctx, 30*time.Millisecond) },
+			wantTimeout: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := eero.NewClient(eero.WithTimeout(tc.clientLimit))
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			if tc.override != nil {
+				ctx = tc.override(ctx)
+			}
+
+			_, err = client.Network.GetSpeedTest(ctx, "/2.2/networks/44444/speedtest")
+			var te *eero.TransportError
+			if tc.wantTimeout {
+				if !errors.As(err, &te) || !te.Timeout() {
+					t.Fatalf("Expected a timeout *TransportError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestCancelMidBody_ReusesConnection cancels a request while the response
+// body is still streaming and verifies that the caller gets a clean
+// context error and that the connection is drained back into the pool
+// rather than torn down.
+func TestCancelMidBody_ReusesConnection(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	var requests int32
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > 1 {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Test User"}}`))
+			return
+		}
+		// Stream the first response slowly so the client cancels mid-body.
+		w.Header().Set("Content-Length", "54")
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, `))
+		w.(http.Flusher).Flush()
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`"data": {"name": "Test User"}}`))
+		close(finished)
+	}))
+	var newConns int32
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&newConns, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		// Let the client receive the headers and begin reading the body.
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := client.Account.Get(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	// Give the background drain a moment to hand the connection back.
+	<-finished
+	time.Sleep(100 * time.Millisecond)
+
+	var reused bool
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+	}
+	ctx2, cancel2 := context.WithTimeout(httptrace.WithClientTrace(context.Background(), trace), 2*time.Second)
+	defer cancel2()
+
+	if _, err := client.Account.Get(ctx2); err != nil {
+		t.Fatalf("Unexpected error on follow-up request: %v", err)
+	}
+	if !reused {
+		t.Error("Expected follow-up request to reuse the drained connection")
+	}
+	if n := atomic.LoadInt32(&newConns); n != 1 {
+		t.Errorf("Expected 1 server connection, got %d", n)
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345/thread", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"meta": {"code": 200, "server_time": "2026-02-21T22:14:52Z"}, "data": {"enabled": true, "channel": 15}}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"enabled":false}` {
+				t.Errorf("Unexpected body %s", body)
+			}
+			if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Expected JSON content type, got %q", ct)
+			}
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	var resp eero.EeroResponse[struct {
+		Enabled bool `json:"enabled"`
+		Channel int  `json:"channel"`
+	}]
+	if err := client.Do(ctx, http.MethodGet, "/2.2/networks/12345/thread", nil, &resp); err != nil {
+		t.Fatalf("Do(GET) error = %v", err)
+	}
+	if !resp.Data.Enabled || resp.Data.Channel != 15 || resp.Meta.ServerTime == "" {
+		t.Errorf("Expected the full envelope to be decoded, got %+v", resp)
+	}
+
+	if err := client.Do(ctx, http.MethodPut, "/2.2/networks/12345/thread", map[string]bool{"enabled": false}, nil); err != nil {
+		t.Errorf("Do(PUT) error = %v", err)
+	}
+
+	var apiErr *eero.APIError
+	if err := client.Do(ctx, http.MethodGet, "/2.2/networks/12345/unknown", nil, nil); !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		t.Errorf("Expected a 404 *APIError, got %v", err)
+	}
+
+	if err := client.Do(ctx, http.MethodGet, "https://evil.example.com/steal", nil, nil); err == nil {
+		t.Error("Expected the origin guard to block a foreign host")
+	}
+}
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"status": "ok", "checks": ["dns", "wan"]}}`))
+	})
+	mux.HandleFunc("/2.2/networks/12345/forbidden", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"meta": {"code": 401, "error": "error.session.invalid"}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	type diagnostics struct {
+		Status string   `json:"status"`
+		Checks []string `json:"checks"`
+	}
+	diag, err := eero.Fetch[diagnostics](ctx, client, http.MethodGet, "/2.2/networks/12345/diagnostics", nil)
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if diag.Status != "ok" || len(diag.Checks) != 2 {
+		t.Errorf("Unexpected data %+v", diag)
+	}
+
+	var apiErr *eero.APIError
+	if _, err := eero.Fetch[diagnostics](ctx, client, http.MethodGet, "/2.2/networks/12345/forbidden", nil); !errors.As(err, &apiErr) || !apiErr.IsAuthError() {
+		t.Errorf("Expected a 401 *APIError, got %v", err)
+	}
+
+	if _, err := eero.Fetch[diagnostics](ctx, client, http.MethodGet, "//evil.example.com/2.2/x", nil); err == nil {
+		t.Error("Expected the origin guard to block a foreign host")
+	}
+}
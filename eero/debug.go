@@ -0,0 +1,85 @@
+package eero
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redactedValue replaces sensitive values in debug dumps.
+const redactedValue = "[REDACTED]"
+
+// dumpResponse writes a redacted, indented JSON rendering of the decoded
+// value v to the client's debug writer (see WithDebugDump).
+func (c *Client) dumpResponse(req *http.Request, v any) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	var generic any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&generic); err != nil {
+		return
+	}
+
+	pretty, err := json.MarshalIndent(redact(generic), "", "  ")
+	if err != nil {
+		return
+	}
+
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
+	_, _ = fmt.Fprintf(c.debugDump, "%s %s\n%s\n", req.Method, req.URL.Path, pretty)
+}
+
+// redact walks a decoded JSON value and replaces the values of sensitive
+// keys in place.
+func redact(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if isSensitiveKey(k) {
+				t[k] = redactedValue
+				continue
+			}
+			t[k] = redact(val)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = redact(val)
+		}
+	}
+	return v
+}
+
+// isSensitiveKey reports whether a JSON key names a credential.
+func isSensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	return strings.Contains(k, "token") || strings.Contains(k, "cookie") || strings.Contains(k, "password")
+}
+
+// redactURL renders u for logging with credentials removed: any user info is
+// dropped, and the values of the "s" session parameter and of other query
+// parameters whose names mention a token, cookie or password are replaced.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	r := *u
+	r.User = nil
+	if r.RawQuery != "" {
+		q := r.Query()
+		for key := range q {
+			if key == "s" || isSensitiveKey(key) {
+				q[key] = []string{redactedValue}
+			}
+		}
+		r.RawQuery = q.Encode()
+	}
+	return r.String()
+}
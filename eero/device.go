@@ -2,15 +2,49 @@ package eero
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// DeviceAPI is the method set of DeviceService and the type of
+// Client.Device. See NetworkAPI for its use with fakes.
+type DeviceAPI interface {
+	List(ctx context.Context, networkURL string, opts ...ListOption) ([]Device, error)
+	ListLenient(ctx context.Context, networkURL string) ([]Device, []DeviceDecodeError, error)
+	ListPage(ctx context.Context, networkURL string, opts ListOptions) ([]Device, string, error)
+	ListAll(ctx context.Context, networkURL string, fn func(Device) error) error
+	Get(ctx context.Context, deviceURL string) (*Device, error)
+	SetNickname(ctx context.Context, deviceURL, nickname string) error
+	Block(ctx context.Context, deviceURL string) error
+	Unblock(ctx context.Context, deviceURL string) error
+	Pause(ctx context.Context, deviceURL string) error
+	Unpause(ctx context.Context, deviceURL string) error
+	ConnectionHistory(ctx context.Context, deviceURL string) ([]RoamEvent, error)
+	FindByStableID(ctx context.Context, networkURL, id string) (*Device, error)
+	FindByMAC(ctx context.Context, networkURL, mac string) (*Device, error)
+	FindByNickname(ctx context.Context, networkURL, name string) (*Device, error)
+	ListSorted(ctx context.Context, networkURL string, by DeviceSortField) ([]Device, error)
+	WaitForOnline(ctx context.Context, networkURL, mac string, poll time.Duration) (*Device, error)
+}
+
 // DeviceService provides access to devices connected to an eero network.
 type DeviceService struct {
 	client *Client
 }
 
+var _ DeviceAPI = (*DeviceService)(nil)
+
 // --- Response types ---
 
 // Device represents a single client device connected to the eero network.
@@ -59,6 +93,66 @@ type Device struct {
 	ModelName                *string            `json:"model_name"`
 }
 
+// AuthMethod is the normalized form of Device.Auth, the security a wireless
+// device used to join the network.
+type AuthMethod string
+
+// Authentication methods returned by Device.AuthTyped.
+const (
+	// AuthOpen: no encryption at all.
+	AuthOpen AuthMethod = "open"
+	// AuthOWE: Enhanced Open (Opportunistic Wireless Encryption) —
+	// encrypted but unauthenticated.
+	AuthOWE AuthMethod = "owe"
+	// AuthWEP: WEP, trivially broken.
+	AuthWEP AuthMethod = "wep"
+	// AuthWPA: original WPA (TKIP), deprecated.
+	AuthWPA AuthMethod = "wpa"
+	// AuthWPA2: WPA2, personal or enterprise.
+	AuthWPA2 AuthMethod = "wpa2"
+	// AuthWPA3: WPA3 (SAE or enterprise).
+	AuthWPA3 AuthMethod = "wpa3"
+	// AuthUnknown is returned for empty or unrecognized values, including
+	// wired devices, which report no auth.
+	AuthUnknown AuthMethod = "unknown"
+)
+
+// AuthTyped maps the raw Auth string (e.g. "wpa2", "open", "wpa3-sae") onto
+// one of the AuthMethod constants. Mixed values such as "wpa2/wpa3" map to
+// the weaker method.
+func (d *Device) AuthTyped() AuthMethod {
+	auth := strings.ToLower(strings.TrimSpace(d.Auth))
+	switch {
+	case auth == "":
+		return AuthUnknown
+	case auth == "open" || auth == "none":
+		return AuthOpen
+	case strings.HasPrefix(auth, "owe"):
+		return AuthOWE
+	case strings.HasPrefix(auth, "wep"):
+		return AuthWEP
+	case strings.HasPrefix(auth, "wpa3"), strings.HasPrefix(auth, "sae"):
+		return AuthWPA3
+	case strings.HasPrefix(auth, "wpa2"), strings.HasPrefix(auth, "rsn"):
+		return AuthWPA2
+	case strings.HasPrefix(auth, "wpa"):
+		return AuthWPA
+	default:
+		return AuthUnknown
+	}
+}
+
+// IsInsecurelyConnected reports whether the device joined over Wi-Fi with
+// no or legacy security: open, WEP or original WPA. Wired devices and
+// unrecognized auth values report false.
+func (d *Device) IsInsecurelyConnected() bool {
+	switch d.AuthTyped() {
+	case AuthOpen, AuthWEP, AuthWPA:
+		return true
+	}
+	return false
+}
+
 // DeviceRef is a lightweight reference to a profile from within a device.
 type DeviceRef struct {
 	URL  string `json:"url"`
@@ -105,6 +199,94 @@ type RateInfo struct {
 	PhyType       *string `json:"phy_type"`
 }
 
+// WiFiSummary is a human-friendly digest of a device's negotiated Wi-Fi
+// link, derived from DeviceConnectivity rate info. Fields the API did not
+// report are left at their zero value.
+type WiFiSummary struct {
+	Generation   string // e.g. "Wi-Fi 6"
+	Streams      int    // spatial streams (NSS); 2 means 2x2
+	ChannelWidth string // e.g. "80MHz"
+	PhyType      string // e.g. "HE"
+	MCS          *int
+}
+
+// String renders the summary as, e.g., "Wi-Fi 6, 2x2, 80MHz, HE", omitting
+// unknown parts. It returns "" when nothing is known.
+func (w WiFiSummary) String() string {
+	var parts []string
+	if w.Generation != "" {
+		parts = append(parts, w.Generation)
+	}
+	if w.Streams > 0 {
+		parts = append(parts, fmt.Sprintf("%dx%d", w.Streams, w.Streams))
+	}
+	if w.ChannelWidth != "" {
+		parts = append(parts, w.ChannelWidth)
+	}
+	if w.PhyType != "" {
+		parts = append(parts, w.PhyType)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// WiFiSummary summarizes the device's Wi-Fi capabilities from its rate info.
+// Receive-side values are preferred, with transmit-side values filling any
+// gaps; nil fields are skipped. Devices without rate info (e.g. wired ones)
+// yield an empty summary.
+func (c DeviceConnectivity) WiFiSummary() WiFiSummary {
+	var w WiFiSummary
+	for _, ri := range []RateInfo{c.RxRateInfo, c.TxRateInfo} {
+		if w.PhyType == "" && ri.PhyType != nil {
+			w.PhyType = strings.ToUpper(strings.TrimSpace(*ri.PhyType))
+		}
+		if w.Streams == 0 && ri.NSS != nil && *ri.NSS > 0 {
+			w.Streams = *ri.NSS
+		}
+		if w.ChannelWidth == "" && ri.ChannelWidth != nil {
+			w.ChannelWidth = normalizeChannelWidth(*ri.ChannelWidth)
+		}
+		if w.MCS == nil && ri.MCS != nil {
+			mcs := *ri.MCS
+			w.MCS = &mcs
+		}
+	}
+	w.Generation = wifiGeneration(w.PhyType)
+	return w
+}
+
+// wifiGeneration maps an 802.11 PHY type onto its Wi-Fi Alliance name.
+func wifiGeneration(phy string) string {
+	switch phy {
+	case "EHT":
+		return "Wi-Fi 7"
+	case "HE":
+		return "Wi-Fi 6"
+	case "VHT":
+		return "Wi-Fi 5"
+	case "HT":
+		return "Wi-Fi 4"
+	default:
+		return ""
+	}
+}
+
+// normalizeChannelWidth reduces the API's channel width spellings (e.g.
+// "WIDTH_80MHz", "80 MHz", "80") to the form "80MHz".
+func normalizeChannelWidth(width string) string {
+	var digits strings.Builder
+	for i := 0; i < len(width); i++ {
+		if c := width[i]; '0' <= c && c <= '9' {
+			digits.WriteByte(c)
+		} else if digits.Len() > 0 {
+			break
+		}
+	}
+	if digits.Len() == 0 {
+		return ""
+	}
+	return digits.String() + "MHz"
+}
+
 // EthernetStatus describes a wired link.
 type EthernetStatus struct {
 	Value any `json:"value"` // Abstract generic field due to API variances.
@@ -129,9 +311,100 @@ type RingLTE struct {
 	LTEEnabled    bool `json:"lte_enabled"`
 }
 
+// RoamEvent is a single entry in a device's connection history, recording
+// when the device attached to (or moved between) eero nodes. Node references
+// are pointers because the API omits them for events such as disconnects.
+type RoamEvent struct {
+	Timestamp EeroTime      `json:"timestamp"`
+	Event     string        `json:"event"` // e.g. "connected", "roamed", "disconnected"
+	From      *DeviceSource `json:"from"`
+	To        *DeviceSource `json:"to"`
+	Frequency *int          `json:"frequency"`
+	Signal    *string       `json:"signal"`
+}
+
+// nicknameRequest is the body for renaming a device. A nil Nickname encodes
+// as JSON null, which clears the nickname server-side.
+type nicknameRequest struct {
+	Nickname *string `json:"nickname"`
+}
+
+// DefaultDevicePageSize is the page size ListAll requests from the API.
+const DefaultDevicePageSize = 100
+
+// ListOptions selects one page of a paginated listing. The zero value asks
+// for the first page at the server's default size.
+type ListOptions struct {
+	// Limit is the maximum number of items per page; zero leaves it to the
+	// server.
+	Limit int
+	// Cursor is the opaque cursor returned with the previous page; empty
+	// for the first page.
+	Cursor string
+}
+
+// pageMeta is the part of a paginated response's meta envelope that carries
+// the cursor for the following page.
+type pageMeta struct {
+	NextCursor string `json:"next_cursor"`
+}
+
+// blacklistRequest is the body for blocking/unblocking a device.
+type blacklistRequest struct {
+	Blacklisted bool `json:"blacklisted"`
+}
+
 // --- Methods ---
 
-// List returns all devices connected to the specified network.
+// ListOption filters the devices returned by DeviceService.List. Options
+// combine with AND semantics.
+//
+// The devices endpoint has no documented filter parameters, so filtering is
+// applied client-side after the full list is fetched; use ListPage to bound
+// the response size instead.
+type ListOption func(*listFilter)
+
+type listFilter struct {
+	connected  bool
+	guests     bool
+	profileURL string
+	byProfile  bool
+}
+
+// OnlyConnected keeps only devices that are currently connected.
+func OnlyConnected() ListOption {
+	return func(f *listFilter) { f.connected = true }
+}
+
+// OnlyGuests keeps only devices on the guest network.
+func OnlyGuests() ListOption {
+	return func(f *listFilter) { f.guests = true }
+}
+
+// InProfile keeps only devices assigned to the profile with the given URL
+// (e.g., "/2.2/networks/12345/profiles/678"). An empty URL keeps devices
+// that are not assigned to any profile.
+func InProfile(profileURL string) ListOption {
+	return func(f *listFilter) {
+		f.byProfile = true
+		f.profileURL = profileURL
+	}
+}
+
+func (f *listFilter) match(d *Device) bool {
+	switch {
+	case f.connected && !d.Connected:
+		return false
+	case f.guests && !d.IsGuest:
+		return false
+	case f.byProfile && d.Profile.URL != f.profileURL:
+		return false
+	}
+	return true
+}
+
+// List returns all devices connected to the specified network, narrowed by
+// any ListOption filters.
 //
 // The networkURL parameter should be the exact relative URL from the account
 // response (e.g., "/2.2/networks/12345"). The "/devices" suffix is appended
@@ -139,7 +412,7 @@ type RingLTE struct {
 //
 // The response is unmarshaled into EeroResponse[[]Device], but only the
 // []Device slice is returned to the caller.
-func (s *DeviceService) List(ctx context.Context, networkURL string) ([]Device, error) {
+func (s *DeviceService) List(ctx context.Context, networkURL string, opts ...ListOption) ([]Device, error) {
 	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodGet, networkURL+"/devices", nil)
 	if err != nil {
 		return nil, err
@@ -150,5 +423,777 @@ func (s *DeviceService) List(ctx context.Context, networkURL string) ([]Device,
 		return nil, fmt.Errorf("device: %w", err)
 	}
 
+	if len(opts) == 0 {
+		return resp.Data, nil
+	}
+	var f listFilter
+	for _, opt := range opts {
+		opt(&f)
+	}
+	out := resp.Data[:0]
+	for i := range resp.Data {
+		if f.match(&resp.Data[i]) {
+			out = append(out, resp.Data[i])
+		}
+	}
+	return out, nil
+}
+
+// DeviceDecodeError describes one entry of a device list that could not be
+// decoded by ListLenient.
+type DeviceDecodeError struct {
+	// Index is the entry's position in the response's data array.
+	Index int
+	// MAC is the entry's "mac" field if it could still be read, else empty.
+	MAC string
+	// Raw is the entry exactly as the server sent it.
+	Raw json.RawMessage
+	// Err is the decoding error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e DeviceDecodeError) Error() string {
+	if e.MAC != "" {
+		return fmt.Sprintf("device: decoding entry %d (%s): %v", e.Index, e.MAC, e.Err)
+	}
+	return fmt.Sprintf("device: decoding entry %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e DeviceDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ListLenient is like List but decodes the device list entry by entry, so a
+// single malformed device (for example an unexpected timestamp format) does
+// not fail the whole call. Devices that decode are returned in order; the
+// others are reported as DeviceDecodeErrors. The error result is reserved
+// for request failures and a data field that is not an array at all.
+func (s *DeviceService) ListLenient(ctx context.Context, networkURL string) ([]Device, []DeviceDecodeError, error) {
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodGet, networkURL+"/devices", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp EeroResponse[[]json.RawMessage]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, nil, fmt.Errorf("device: %w", err)
+	}
+
+	devices := make([]Device, 0, len(resp.Data))
+	var decodeErrs []DeviceDecodeError
+	for i, raw := range resp.Data {
+		var d Device
+		if err := json.Unmarshal(raw, &d); err != nil {
+			var id struct {
+				MAC string `json:"mac"`
+			}
+			_ = json.Unmarshal(raw, &id)
+			decodeErrs = append(decodeErrs, DeviceDecodeError{Index: i, MAC: id.MAC, Raw: raw, Err: err})
+			continue
+		}
+		devices = append(devices, d)
+	}
+
+	return devices, decodeErrs, nil
+}
+
+// ListPage returns one page of the network's devices and the cursor for the
+// next page, which is empty once the last page has been returned. Pass the
+// cursor back in opts.Cursor to continue. A negative opts.Limit returns
+// ErrInvalidArgument before any request is sent.
+//
+// Paging keeps each response well under the 5MB body limit on networks with
+// hundreds of clients; see ListAll for walking every page.
+func (s *DeviceService) ListPage(ctx context.Context, networkURL string, opts ListOptions) ([]Device, string, error) {
+	if opts.Limit < 0 {
+		return nil, "", fmt.Errorf("device: list page: %w: negative limit %d", ErrInvalidArgument, opts.Limit)
+	}
+
+	q := url.Values{}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Cursor != "" {
+		q.Set("cursor", opts.Cursor)
+	}
+	path := networkURL + "/devices"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodGet, path, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var resp struct {
+		Meta pageMeta `json:"meta"`
+		Data []Device `json:"data"`
+	}
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, "", fmt.Errorf("device: list page: %w", err)
+	}
+
+	return resp.Data, resp.Meta.NextCursor, nil
+}
+
+// ListAll walks every page of the network's devices, DefaultDevicePageSize
+// at a time, calling fn for each device in order. It stops at the last page,
+// or as soon as fn returns an error, which is returned unchanged.
+func (s *DeviceService) ListAll(ctx context.Context, networkURL string, fn func(Device) error) error {
+	opts := ListOptions{Limit: DefaultDevicePageSize}
+	for {
+		devices, next, err := s.ListPage(ctx, networkURL, opts)
+		if err != nil {
+			return err
+		}
+		for _, d := range devices {
+			if err := fn(d); err != nil {
+				return err
+			}
+		}
+		if next == "" || len(devices) == 0 {
+			return nil
+		}
+		if next == opts.Cursor {
+			return fmt.Errorf("device: list all: server repeated cursor %q", next)
+		}
+		opts.Cursor = next
+	}
+}
+
+// Get returns a single device by its URL. Prefer it over List when polling
+// one device, since only that device's record is transferred.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef"). A device that has
+// been removed from the network surfaces as an *APIError with status 404.
+func (s *DeviceService) Get(ctx context.Context, deviceURL string) (*Device, error) {
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodGet, deviceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[Device]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("device: get: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// SetNickname sets the display nickname for the given device. Passing an
+// empty nickname clears it (the API receives JSON null rather than "").
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef"). A removed or unknown
+// device surfaces as an *APIError with the server's status code.
+func (s *DeviceService) SetNickname(ctx context.Context, deviceURL, nickname string) error {
+	var body nicknameRequest
+	if nickname != "" {
+		body.Nickname = &nickname
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodPut, deviceURL, body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("device: set nickname: %w", err)
+	}
+
+	return nil
+}
+
+// Block blocks the given device from the network by blacklisting it.
+// Blocking an already-blocked device is a no-op, so it is safe to retry.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef").
+func (s *DeviceService) Block(ctx context.Context, deviceURL string) error {
+	return s.setBlacklisted(ctx, deviceURL, true)
+}
+
+// Unblock removes the given device from the network blacklist.
+// Unblocking a device that is not blocked is a no-op, so it is safe to retry.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef").
+func (s *DeviceService) Unblock(ctx context.Context, deviceURL string) error {
+	return s.setBlacklisted(ctx, deviceURL, false)
+}
+
+func (s *DeviceService) setBlacklisted(ctx context.Context, deviceURL string, blacklisted bool) error {
+	body := blacklistRequest{Blacklisted: blacklisted}
+
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodPut, deviceURL, body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("device: block: %w", err)
+	}
+
+	return nil
+}
+
+// Pause pauses internet access for a single device, independent of its
+// profile. Devices that report RingLTE.IsNotPausable (e.g. Ring Alarm Pro
+// managed hardware) are rejected by the API; that rejection is returned as
+// an *APIError carrying the server's message.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef").
+func (s *DeviceService) Pause(ctx context.Context, deviceURL string) error {
+	return s.setPaused(ctx, deviceURL, true)
+}
+
+// Unpause resumes internet access for a single device.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef").
+func (s *DeviceService) Unpause(ctx context.Context, deviceURL string) error {
+	return s.setPaused(ctx, deviceURL, false)
+}
+
+func (s *DeviceService) setPaused(ctx context.Context, deviceURL string, paused bool) error {
+	body := pauseRequest{Paused: paused}
+
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodPut, deviceURL, body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("device: pause: %w", err)
+	}
+
+	return nil
+}
+
+// ConnectionHistory returns the recent connection and roaming events for the
+// given device, oldest first as reported by the API. Comparing the From and
+// To nodes across events helps diagnose "sticky" clients that refuse to roam
+// to a closer node.
+//
+// The deviceURL parameter should be the exact relative URL from the device
+// response (e.g., "/2.2/networks/12345/devices/abcdef"). The
+// "/connection_history" suffix is appended automatically.
+func (s *DeviceService) ConnectionHistory(ctx context.Context, deviceURL string) ([]RoamEvent, error) {
+	req, err := s.client.newRequestFromURL(ctx, "device", http.MethodGet, deviceURL+"/connection_history", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[[]RoamEvent]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("device: connection history: %w", err)
+	}
+
 	return resp.Data, nil
 }
+
+// FindByStableID returns the device on the network whose StableID equals id.
+// Use it to re-identify devices that rotate their MAC address (for example
+// before assigning them to a profile), since their URL and MAC are not stable
+// across reconnections. It returns an error wrapping ErrDeviceNotFound when no
+// device matches.
+func (s *DeviceService) FindByStableID(ctx context.Context, networkURL, id string) (*Device, error) {
+	devices, err := s.List(ctx, networkURL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		if devices[i].StableID() == id {
+			return &devices[i], nil
+		}
+	}
+	return nil, fmt.Errorf("device: stable ID %q: %w", id, ErrDeviceNotFound)
+}
+
+// FindByMAC returns the device on the network with the given MAC address.
+// Case and separators are ignored, so "AA:BB:CC:DD:EE:FF" matches
+// "aa-bb-cc-dd-ee-ff". It returns an error wrapping ErrDeviceNotFound when no
+// device matches.
+func (s *DeviceService) FindByMAC(ctx context.Context, networkURL, mac string) (*Device, error) {
+	want := normalizeMAC(mac)
+	if want == "" {
+		return nil, fmt.Errorf("device: find by MAC: %w: empty MAC", ErrInvalidArgument)
+	}
+	devices, err := s.List(ctx, networkURL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		if normalizeMAC(devices[i].MAC) == want {
+			return &devices[i], nil
+		}
+	}
+	return nil, fmt.Errorf("device: MAC %q: %w", mac, ErrDeviceNotFound)
+}
+
+// FindByNickname returns the first device on the network whose nickname
+// equals name, compared case-insensitively. Devices without a nickname never
+// match. It returns an error wrapping ErrDeviceNotFound when no device
+// matches.
+func (s *DeviceService) FindByNickname(ctx context.Context, networkURL, name string) (*Device, error) {
+	if name == "" {
+		return nil, fmt.Errorf("device: find by nickname: %w: empty name", ErrInvalidArgument)
+	}
+	devices, err := s.List(ctx, networkURL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		if nick := devices[i].Nickname; nick != nil && strings.EqualFold(*nick, name) {
+			return &devices[i], nil
+		}
+	}
+	return nil, fmt.Errorf("device: nickname %q: %w", name, ErrDeviceNotFound)
+}
+
+// ListSorted returns the devices on the network ordered by the given field;
+// see SortDevices for the ordering rules.
+func (s *DeviceService) ListSorted(ctx context.Context, networkURL string, by DeviceSortField) ([]Device, error) {
+	if !by.valid() {
+		return nil, fmt.Errorf("device: list sorted: %w: unknown sort field %q", ErrInvalidArgument, by)
+	}
+	devices, err := s.List(ctx, networkURL)
+	if err != nil {
+		return nil, err
+	}
+	SortDevices(devices, by)
+	return devices, nil
+}
+
+// WaitForOnline polls List every poll interval until the device with the
+// given MAC address (compared case-insensitively, ignoring separators)
+// reports Connected, and returns it. The first check happens immediately.
+// List errors are tolerated so the wait survives a network reboot.
+//
+// The wait ends only when the device connects or ctx is done, so pass a
+// context with a deadline. On expiry the error wraps ctx.Err() and states
+// whether the device was seen disconnected or never appeared at all.
+func (s *DeviceService) WaitForOnline(ctx context.Context, networkURL, mac string, poll time.Duration) (*Device, error) {
+	if poll <= 0 {
+		return nil, fmt.Errorf("device: wait for online: %w: poll interval must be positive", ErrInvalidArgument)
+	}
+	want := normalizeMAC(mac)
+	if want == "" {
+		return nil, fmt.Errorf("device: wait for online: %w: empty MAC", ErrInvalidArgument)
+	}
+
+	var (
+		seen    bool
+		lastErr error
+	)
+	for {
+		devices, err := s.List(ctx, networkURL)
+		if err != nil {
+			lastErr = err
+		}
+		for i := range devices {
+			if normalizeMAC(devices[i].MAC) != want {
+				continue
+			}
+			if devices[i].Connected {
+				return &devices[i], nil
+			}
+			seen = true
+		}
+
+		if err := sleepContext(ctx, poll); err != nil {
+			switch {
+			case seen:
+				return nil, fmt.Errorf("device: wait for %s online: %w (device present but not connected)", mac, err)
+			case lastErr != nil:
+				return nil, fmt.Errorf("device: wait for %s online: %w (device not seen; last error: %v)", mac, err, lastErr)
+			default:
+				return nil, fmt.Errorf("device: wait for %s online: %w (device not seen)", mac, err)
+			}
+		}
+	}
+}
+
+// --- Helpers ---
+
+// FilterPrivateMAC returns the devices that connect with a private
+// (randomized) MAC address, as reported by Device.IsPrivate.
+//
+// Modern phones and laptops rotate their MAC per network or periodically.
+// Such devices may show up as new entries after a rotation, so anything keyed
+// on the MAC (or the device URL, which embeds it) can silently stop matching.
+// Prefer Device.StableID when tracking or assigning these devices.
+func FilterPrivateMAC(devices []Device) []Device {
+	var private []Device
+	for _, d := range devices {
+		if d.IsPrivate {
+			private = append(private, d)
+		}
+	}
+	return private
+}
+
+// GlobalIPv6 returns the device's first globally routable IPv6 address, as
+// reported, or false if it has none (for example when offline).
+func (d *Device) GlobalIPv6() (string, bool) {
+	return firstIPv6(d.IPv6Addresses, IPv6Address.IsGlobal)
+}
+
+// LinkLocalIPv6 returns the device's first link-local IPv6 address, or false
+// if it has none.
+func (d *Device) LinkLocalIPv6() (string, bool) {
+	return firstIPv6(d.IPv6Addresses, IPv6Address.IsLinkLocal)
+}
+
+// PrimaryIP returns the device's best available IPv4 address: IP, then the
+// IPv4 field, then the first IPv4 entry of IPs. Values that are not valid
+// IPv4 addresses are skipped, and "" is returned when there is none (for
+// example for an offline device).
+func (d *Device) PrimaryIP() string {
+	candidates := make([]string, 0, 2+len(d.IPs))
+	if d.IP != nil {
+		candidates = append(candidates, *d.IP)
+	}
+	candidates = append(candidates, d.IPv4)
+	candidates = append(candidates, d.IPs...)
+	for _, s := range candidates {
+		if addr, err := netip.ParseAddr(strings.TrimSpace(s)); err == nil && addr.Is4() {
+			return addr.String()
+		}
+	}
+	return ""
+}
+
+// AllIPs returns every address reported in IP, IPv4 and IPs, in that order,
+// without duplicates or empty values. Addresses that parse are compared in
+// canonical form, so "::1" and "0:0::1" count once.
+func (d *Device) AllIPs() []string {
+	candidates := make([]string, 0, 2+len(d.IPs))
+	if d.IP != nil {
+		candidates = append(candidates, *d.IP)
+	}
+	candidates = append(candidates, d.IPv4)
+	candidates = append(candidates, d.IPs...)
+
+	var out []string
+	seen := make(map[string]bool, len(candidates))
+	for _, s := range candidates {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		key := s
+		if addr, err := netip.ParseAddr(s); err == nil {
+			key = addr.String()
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+// BestName returns the most human-friendly name available for the device:
+// the user-set Nickname, then DisplayName, Hostname, Manufacturer, and
+// finally the MAC address. Empty values are skipped.
+func (d *Device) BestName() string {
+	for _, p := range []*string{d.Nickname, d.DisplayName, d.Hostname, d.Manufacturer} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			return *p
+		}
+	}
+	return d.MAC
+}
+
+// devicesCSVHeader is the header row written by WriteDevicesCSV.
+var devicesCSVHeader = []string{"name", "mac", "ip", "connection_type", "last_active", "profile"}
+
+// WriteDevicesCSV writes devices to w as CSV: a header row followed by one
+// row per device with its BestName, MAC, PrimaryIP, connection type, last active
+// time (RFC 3339, UTC) and profile name. Missing optional values, such as
+// the IP of an offline device, are written as empty fields; fields containing
+// commas, quotes or newlines are quoted per RFC 4180.
+func WriteDevicesCSV(w io.Writer, devices []Device) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(devicesCSVHeader); err != nil {
+		return fmt.Errorf("device: writing csv: %w", err)
+	}
+	for i := range devices {
+		d := &devices[i]
+		lastActive := ""
+		if !d.LastActive.IsZero() {
+			lastActive = d.LastActive.UTC().Format(time.RFC3339)
+		}
+		row := []string{d.BestName(), d.MAC, d.PrimaryIP(), d.connectionKind(), lastActive, d.Profile.Name}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("device: writing csv: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("device: writing csv: %w", err)
+	}
+	return nil
+}
+
+// connectionKind returns ConnectionType, or "wireless"/"wired" derived from
+// Wireless when the API did not report a type.
+func (d *Device) connectionKind() string {
+	if d.ConnectionType != "" {
+		return d.ConnectionType
+	}
+	if d.Wireless {
+		return "wireless"
+	}
+	return "wired"
+}
+
+// FilterClients returns the devices that are real clients, excluding eero
+// hardware that shows up in the device list: proxied mesh nodes
+// (Device.IsProxiedNode) and the eeros themselves, identified by a device
+// type or manufacturer of "eero". Use it when counting devices, since
+// including the nodes inflates the count by one per eero.
+func FilterClients(devices []Device) []Device {
+	var clients []Device
+	for _, d := range devices {
+		if !d.isEeroHardware() {
+			clients = append(clients, d)
+		}
+	}
+	return clients
+}
+
+// ConnectedClientCount returns the number of client devices currently
+// connected, excluding eero hardware as FilterClients does. It is the
+// accurate "devices connected" figure for dashboards.
+func ConnectedClientCount(devices []Device) int {
+	n := 0
+	for _, d := range FilterClients(devices) {
+		if d.Connected {
+			n++
+		}
+	}
+	return n
+}
+
+// isEeroHardware reports whether d is an eero node rather than a client.
+func (d *Device) isEeroHardware() bool {
+	if d.IsProxiedNode || strings.EqualFold(d.DeviceType, "eero") {
+		return true
+	}
+	return d.Manufacturer != nil && strings.HasPrefix(strings.ToLower(*d.Manufacturer), "eero")
+}
+
+// DeviceSortField selects the ordering used by SortDevices and
+// DeviceService.ListSorted.
+type DeviceSortField string
+
+// Sort orders for device listings.
+const (
+	// SortByLastActive orders the most recently active devices first.
+	SortByLastActive DeviceSortField = "last_active"
+	// SortByNickname orders by nickname, A to Z, ignoring case. Devices
+	// without a nickname sort last.
+	SortByNickname DeviceSortField = "nickname"
+	// SortByScore orders by connectivity score, strongest first.
+	SortByScore DeviceSortField = "score"
+	// SortByIP orders by IPv4/IPv6 address, lowest first. Devices without
+	// an IP (typically offline ones) sort last.
+	SortByIP DeviceSortField = "ip"
+)
+
+func (f DeviceSortField) valid() bool {
+	switch f {
+	case SortByLastActive, SortByNickname, SortByScore, SortByIP:
+		return true
+	}
+	return false
+}
+
+// SortDevices sorts devices in place by the given field. Ties, including
+// devices that both lack the field, are broken by normalized MAC so the
+// order is deterministic across calls. An unknown field sorts by MAC alone.
+func SortDevices(devices []Device, by DeviceSortField) {
+	sort.SliceStable(devices, func(i, j int) bool {
+		a, b := &devices[i], &devices[j]
+		if c := compareDevices(a, b, by); c != 0 {
+			return c < 0
+		}
+		return normalizeMAC(a.MAC) < normalizeMAC(b.MAC)
+	})
+}
+
+// compareDevices returns -1 if a sorts before b on the field, +1 if after,
+// and 0 if they tie.
+func compareDevices(a, b *Device, by DeviceSortField) int {
+	switch by {
+	case SortByLastActive:
+		return -a.LastActive.Compare(b.LastActive.Time)
+	case SortByNickname:
+		return compareNilLast(a.Nickname, b.Nickname, func(x, y string) int {
+			return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+		})
+	case SortByScore:
+		switch {
+		case a.Connectivity.Score > b.Connectivity.Score:
+			return -1
+		case a.Connectivity.Score < b.Connectivity.Score:
+			return 1
+		}
+	case SortByIP:
+		return compareNilLast(a.IP, b.IP, compareIP)
+	}
+	return 0
+}
+
+// compareNilLast compares two optional strings with cmp, ordering nil after
+// any value.
+func compareNilLast(a, b *string, cmp func(x, y string) int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp(*a, *b)
+}
+
+// compareIP orders parseable addresses numerically (IPv4 before IPv6) ahead
+// of unparseable strings, which compare lexically.
+func compareIP(x, y string) int {
+	ax, errX := netip.ParseAddr(x)
+	ay, errY := netip.ParseAddr(y)
+	switch {
+	case errX == nil && errY == nil:
+		return ax.Compare(ay)
+	case errX == nil:
+		return -1
+	case errY == nil:
+		return 1
+	}
+	return strings.Compare(x, y)
+}
+
+// DedupeDevices collapses entries that share a normalized MAC address, which
+// the API occasionally reports twice (e.g. dual-band artifacts). For each MAC
+// the connected record wins; between records with the same connection state,
+// the one with more populated optional fields wins. The first-seen order of
+// MACs is preserved, and devices without a MAC are passed through unchanged.
+func DedupeDevices(devices []Device) []Device {
+	out := make([]Device, 0, len(devices))
+	seen := make(map[string]int, len(devices))
+	for _, d := range devices {
+		key := normalizeMAC(d.MAC)
+		if key == "" {
+			out = append(out, d)
+			continue
+		}
+		i, ok := seen[key]
+		if !ok {
+			seen[key] = len(out)
+			out = append(out, d)
+			continue
+		}
+		if preferDevice(&d, &out[i]) {
+			out[i] = d
+		}
+	}
+	return out
+}
+
+// preferDevice reports whether a should replace b when deduplicating.
+func preferDevice(a, b *Device) bool {
+	if a.Connected != b.Connected {
+		return a.Connected
+	}
+	return a.completeness() > b.completeness()
+}
+
+// completeness counts the optional fields populated on d, as a rough
+// measure of how much the API knew about the device.
+func (d *Device) completeness() int {
+	n := 0
+	for _, p := range []*string{d.Manufacturer, d.IP, d.Nickname, d.Hostname, d.DisplayName, d.ModelName} {
+		if p != nil && *p != "" {
+			n++
+		}
+	}
+	if d.Usage != nil {
+		n++
+	}
+	if len(d.IPs) > 0 {
+		n++
+	}
+	return n
+}
+
+// StableID returns a best-effort identifier for the device that survives MAC
+// randomization. For devices using their hardware MAC, the normalized MAC is
+// returned. For private-MAC devices, the hostname is preferred, then the
+// EUI-64, falling back to the normalized MAC when neither is reported.
+func (d *Device) StableID() string {
+	if d.IsPrivate {
+		if d.Hostname != nil && *d.Hostname != "" {
+			return "host:" + strings.ToLower(*d.Hostname)
+		}
+		if d.EUI64 != "" {
+			return "eui64:" + strings.ToLower(d.EUI64)
+		}
+	}
+	return "mac:" + normalizeMAC(d.MAC)
+}
+
+// Fingerprint returns a heuristic, best-effort identifier for the device
+// that, unlike StableID, does not depend on the MAC at all. It hashes the
+// EUI-64, manufacturer, model name and hostname (normalized for case,
+// whitespace and address separators) into a 16-character hex string.
+//
+// The result is deterministic and unaffected by volatile fields such as the
+// MAC, IP or connection state, so it can match private-MAC devices across
+// rotations. It is not guaranteed unique: identical devices with no hostname
+// collide, and renaming a device changes its fingerprint.
+func (d *Device) Fingerprint() string {
+	parts := []string{
+		normalizeMAC(d.EUI64),
+		fingerprintField(d.Manufacturer),
+		fingerprintField(d.ModelName),
+		fingerprintField(d.Hostname),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// fingerprintField normalizes an optional string for Fingerprint.
+func fingerprintField(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(*s))
+}
+
+// normalizeMAC lowercases a MAC address and strips the ':', '-', and '.'
+// separators so that differently formatted addresses compare equal.
+func normalizeMAC(mac string) string {
+	var b strings.Builder
+	b.Grow(len(mac))
+	for i := 0; i < len(mac); i++ {
+		switch c := mac[i]; c {
+		case ':', '-', '.':
+		default:
+			if 'A' <= c && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -1,9 +1,17 @@
 package eero_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -184,3 +192,1313 @@ func safeStr(s *string) string {
 	}
 	return *s
 }
+
+func TestFilterPrivateMAC(t *testing.T) {
+	t.Parallel()
+
+	devices := []eero.Device{
+		{MAC: "AA:BB:CC:DD:EE:01", IsPrivate: false},
+		{MAC: "3a:bb:cc:dd:ee:02", IsPrivate: true, Hostname: ptr("Pixel-8")},
+		{MAC: "7e:bb:cc:dd:ee:03", IsPrivate: true, EUI64: "7C:BB:CC:FF:FE:DD:EE:03"},
+	}
+
+	private := eero.FilterPrivateMAC(devices)
+	if len(private) != 2 {
+		t.Fatalf("Expected 2 private-MAC devices, got %d", len(private))
+	}
+	if private[0].MAC != "3a:bb:cc:dd:ee:02" || private[1].MAC != "7e:bb:cc:dd:ee:03" {
+		t.Errorf("Unexpected private-MAC devices: %+v", private)
+	}
+
+	tests := []struct {
+		device eero.Device
+		want   string
+	}{
+		{devices[0], "mac:aabbccddee01"},
+		{devices[1], "host:pixel-8"},
+		{devices[2], "eui64:7c:bb:cc:ff:fe:dd:ee:03"},
+	}
+	for _, tc := range tests {
+		if got := tc.device.StableID(); got != tc.want {
+			t.Errorf("StableID() for %s = %q, want %q", tc.device.MAC, got, tc.want)
+		}
+	}
+}
+
+func TestFilterClients(t *testing.T) {
+	t.Parallel()
+
+	devices := []eero.Device{
+		{MAC: "aa:bb:cc:dd:ee:01", Hostname: ptr("laptop")},
+		{MAC: "aa:bb:cc:dd:ee:02", IsProxiedNode: true},
+		{MAC: "aa:bb:cc:dd:ee:03", DeviceType: "eero"},
+		{MAC: "aa:bb:cc:dd:ee:04", Manufacturer: ptr("eero inc.")},
+		{MAC: "aa:bb:cc:dd:ee:05", DeviceType: "phone", Manufacturer: ptr("Apple, Inc.")},
+	}
+
+	clients := eero.FilterClients(devices)
+	if len(clients) != 2 {
+		t.Fatalf("Expected 2 clients, got %d: %+v", len(clients), clients)
+	}
+	if clients[0].MAC != "aa:bb:cc:dd:ee:01" || clients[1].MAC != "aa:bb:cc:dd:ee:05" {
+		t.Errorf("Unexpected clients: %+v", clients)
+	}
+}
+
+func TestConnectedClientCount(t *testing.T) {
+	t.Parallel()
+
+	devices := []eero.Device{
+		{MAC: "aa:bb:cc:dd:ee:01", Connected: true, Hostname: ptr("laptop")},
+		{MAC: "aa:bb:cc:dd:ee:02", Connected: true, DeviceType: "phone", Manufacturer: ptr("Apple, Inc.")},
+		{MAC: "aa:bb:cc:dd:ee:03", Connected: false, Hostname: ptr("old-tablet")},
+		{MAC: "aa:bb:cc:dd:ee:04", Connected: true, IsProxiedNode: true},
+		{MAC: "aa:bb:cc:dd:ee:05", Connected: true, DeviceType: "eero"},
+		{MAC: "aa:bb:cc:dd:ee:06", Connected: true, Manufacturer: ptr("eero inc.")},
+		{MAC: "aa:bb:cc:dd:ee:07", Connected: true, DeviceType: "tv", Manufacturer: ptr("Samsung")},
+		{MAC: "aa:bb:cc:dd:ee:08", Connected: false, IsProxiedNode: true},
+	}
+
+	if got := eero.ConnectedClientCount(devices); got != 3 {
+		t.Errorf("ConnectedClientCount() = %d, want 3", got)
+	}
+	if got := eero.ConnectedClientCount(nil); got != 0 {
+		t.Errorf("ConnectedClientCount(nil) = %d, want 0", got)
+	}
+}
+
+func TestDevice_AuthTyped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		auth         string
+		want         eero.AuthMethod
+		wantInsecure bool
+	}{
+		{auth: "open", want: eero.AuthOpen, wantInsecure: true},
+		{auth: "None", want: eero.AuthOpen, wantInsecure: true},
+		{auth: "wep", want: eero.AuthWEP, wantInsecure: true},
+		{auth: "wpa", want: eero.AuthWPA, wantInsecure: true},
+		{auth: "wpa-psk", want: eero.AuthWPA, wantInsecure: true},
+		{auth: "wpa2", want: eero.AuthWPA2},
+		{auth: "WPA2-PSK", want: eero.AuthWPA2},
+		{auth: "wpa2/wpa3", want: eero.AuthWPA2},
+		{auth: "wpa3-sae", want: eero.AuthWPA3},
+		{auth: "sae", want: eero.AuthWPA3},
+		{auth: "owe", want: eero.AuthOWE},
+		{auth: "", want: eero.AuthUnknown},
+		{auth: "mystery", want: eero.AuthUnknown},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.auth, func(t *testing.T) {
+			t.Parallel()
+			d := eero.Device{Auth: tc.auth}
+			if got := d.AuthTyped(); got != tc.want {
+				t.Errorf("AuthTyped() = %q, want %q", got, tc.want)
+			}
+			if got := d.IsInsecurelyConnected(); got != tc.wantInsecure {
+				t.Errorf("IsInsecurelyConnected() = %v, want %v", got, tc.wantInsecure)
+			}
+		})
+	}
+}
+
+func TestDevice_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	// The same device decoded from payloads with different field orders and
+	// volatile values (MAC, IP, connection state) must fingerprint identically.
+	payloads := []string{
+		`{"mac": "3a:bb:cc:dd:ee:02", "ip": "192.168.4.20", "connected": true, "eui64": "7C:BB:CC:FF:FE:DD:EE:03", "manufacturer": "Google", "model_name": "Pixel 8", "hostname": "Pixel-8"}`,
+		`{"hostname": "pixel-8 ", "model_name": "Pixel 8", "manufacturer": "google", "eui64": "7c-bb-cc-ff-fe-dd-ee-03", "connected": false, "mac": "5e:11:22:33:44:55"}`,
+	}
+
+	var want string
+	for i, p := range payloads {
+		var d eero.Device
+		if err := json.Unmarshal([]byte(p), &d); err != nil {
+			t.Fatalf("Failed to decode payload %d: %v", i, err)
+		}
+		got := d.Fingerprint()
+		if len(got) != 16 {
+			t.Errorf("Expected 16-character fingerprint, got %q", got)
+		}
+		if i == 0 {
+			want = got
+		} else if got != want {
+			t.Errorf("Payload %d fingerprint = %s, want %s", i, got, want)
+		}
+	}
+
+	base := eero.Device{EUI64: "7c:bb:cc:ff:fe:dd:ee:03", Manufacturer: ptr("Google"), ModelName: ptr("Pixel 8"), Hostname: ptr("Pixel-8")}
+	if got := base.Fingerprint(); got != want {
+		t.Errorf("Expected literal device to match decoded fingerprint %s, got %s", want, got)
+	}
+
+	changes := map[string]func(d *eero.Device){
+		"EUI64":        func(d *eero.Device) { d.EUI64 = "7c:bb:cc:ff:fe:dd:ee:04" },
+		"Manufacturer": func(d *eero.Device) { d.Manufacturer = ptr("Samsung") },
+		"ModelName":    func(d *eero.Device) { d.ModelName = ptr("Pixel 9") },
+		"Hostname":     func(d *eero.Device) { d.Hostname = nil },
+	}
+	for field, mutate := range changes {
+		d := base
+		mutate(&d)
+		if d.Fingerprint() == want {
+			t.Errorf("Expected changing %s to change the fingerprint", field)
+		}
+	}
+}
+
+func TestWriteDevicesCSV(t *testing.T) {
+	t.Parallel()
+
+	devices := []eero.Device{
+		{
+			MAC:            "aa:bb:cc:dd:ee:01",
+			Nickname:       ptr("Living Room, TV"),
+			IP:             ptr("192.168.4.20"),
+			ConnectionType: "wireless",
+			LastActive:     eero.EeroTime{Time: time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)},
+			Profile:        eero.DeviceRef{Name: "Family"},
+		},
+		{
+			MAC:      "aa:bb:cc:dd:ee:02",
+			Hostname: ptr("old-laptop"),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := eero.WriteDevicesCSV(&buf, devices); err != nil {
+		t.Fatalf("WriteDevicesCSV: %v", err)
+	}
+
+	want := "name,mac,ip,connection_type,last_active,profile\n" +
+		"\"Living Room, TV\",aa:bb:cc:dd:ee:01,192.168.4.20,wireless,2024-03-01T08:30:00Z,Family\n" +
+		"old-laptop,aa:bb:cc:dd:ee:02,,wired,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Unexpected CSV:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDeviceService_ListFilters(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555/devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{"url": "/d/1", "connected": true, "profile": {"url": "/2.2/networks/55555/profiles/1"}},
+				{"url": "/d/2", "connected": false, "profile": {"url": "/2.2/networks/55555/profiles/1"}},
+				{"url": "/d/3", "connected": true, "is_guest": true},
+				{"url": "/d/4", "connected": false, "is_guest": true}
+			]
+		}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	tests := []struct {
+		name     string
+		opts     []eero.ListOption
+		wantURLs []string
+	}{
+		{name: "NoOptions", wantURLs: []string{"/d/1", "/d/2", "/d/3", "/d/4"}},
+		{name: "OnlyConnected", opts: []eero.ListOption{eero.OnlyConnected()}, wantURLs: []string{"/d/1", "/d/3"}},
+		{name: "OnlyGuests", opts: []eero.ListOption{eero.OnlyGuests()}, wantURLs: []string{"/d/3", "/d/4"}},
+		{name: "InProfile", opts: []eero.ListOption{eero.InProfile("/2.2/networks/55555/profiles/1")}, wantURLs: []string{"/d/1", "/d/2"}},
+		{name: "InNoProfile", opts: []eero.ListOption{eero.InProfile("")}, wantURLs: []string{"/d/3", "/d/4"}},
+		{name: "Combined", opts: []eero.ListOption{eero.OnlyConnected(), eero.OnlyGuests()}, wantURLs: []string{"/d/3"}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			devices, err := client.Device.List(ctx, "/2.2/networks/55555", tc.opts...)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			var got []string
+			for _, d := range devices {
+				got = append(got, d.URL)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantURLs, ",") {
+				t.Errorf("Expected %v, got %v", tc.wantURLs, got)
+			}
+		})
+	}
+}
+
+func TestDeviceService_FindByStableID(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555/devices", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{"url": "/2.2/networks/55555/devices/1", "mac": "AA:BB:CC:DD:EE:01"},
+				{"url": "/2.2/networks/55555/devices/2", "mac": "3a:bb:cc:dd:ee:02", "is_private": true, "hostname": "Pixel-8"}
+			]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	d, err := client.Device.FindByStableID(ctx, "/2.2/networks/55555", "host:pixel-8")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d.URL != "/2.2/networks/55555/devices/2" {
+		t.Errorf("Expected private-MAC device, got %s", d.URL)
+	}
+
+	_, err = client.Device.FindByStableID(ctx, "/2.2/networks/55555", "host:unknown")
+	if !errors.Is(err, eero.ErrDeviceNotFound) {
+		t.Errorf("Expected ErrDeviceNotFound, got %v", err)
+	}
+}
+
+func TestDeviceService_FindByMACAndNickname(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555/devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{"url": "/2.2/networks/55555/devices/1", "mac": "AA:BB:CC:DD:EE:01", "nickname": null},
+				{"url": "/2.2/networks/55555/devices/2", "mac": "aa:bb:cc:dd:ee:02", "nickname": "Living Room TV"}
+			]
+		}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name    string
+		find    func() (*eero.Device, error)
+		wantURL string
+		wantErr error
+	}{
+		{
+			name: "MAC_DashesLowercase",
+			find: func() (*eero.Device, error) {
+				return client.Device.FindByMAC(ctx, "/2.2/networks/55555", "aa-bb-cc-dd-ee-01")
+			},
+			wantURL: "/2.2/networks/55555/devices/1",
+		},
+		{
+			name: "MAC_Uppercase",
+			find: func() (*eero.Device, error) {
+				return client.Device.FindByMAC(ctx, "/2.2/networks/55555", "AA:BB:CC:DD:EE:02")
+			},
+			wantURL: "/2.2/networks/55555/devices/2",
+		},
+		{
+			name: "MAC_NotFound",
+			find: func() (*eero.Device, error) {
+				return client.Device.FindByMAC(ctx, "/2.2/networks/55555", "aa:bb:cc:dd:ee:ff")
+			},
+			wantErr: eero.ErrDeviceNotFound,
+		},
+		{
+			name: "Nickname_CaseInsensitive",
+			find: func() (*eero.Device, error) {
+				return client.Device.FindByNickname(ctx, "/2.2/networks/55555", "living room tv")
+			},
+			wantURL: "/2.2/networks/55555/devices/2",
+		},
+		{
+			name: "Nickname_NotFound",
+			find: func() (*eero.Device, error) {
+				return client.Device.FindByNickname(ctx, "/2.2/networks/55555", "Kitchen")
+			},
+			wantErr: eero.ErrDeviceNotFound,
+		},
+		{
+			name:    "Nickname_Empty",
+			find:    func() (*eero.Device, error) { return client.Device.FindByNickname(ctx, "/2.2/networks/55555", "") },
+			wantErr: eero.ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := tc.find()
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if d.URL != tc.wantURL {
+				t.Errorf("Expected %s, got %s", tc.wantURL, d.URL)
+			}
+		})
+	}
+}
+
+func TestDeviceService_SetNickname(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		nickname     string
+		mockStatus   int
+		mockResponse string
+		expectBody   string
+		wantErr      bool
+	}{
+		{
+			name:         "Success_SetNickname",
+			nickname:     "Living Room TV",
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"nickname":"Living Room TV"}`,
+		},
+		{
+			name:         "Success_EmptyClearsWithNull",
+			nickname:     "",
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"nickname":null}`,
+		},
+		{
+			name:         "Failure_DeviceNotFound",
+			nickname:     "Ghost",
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "error.device.notFound"}, "data": {}}`,
+			expectBody:   `{"nickname":"Ghost"}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceURL := "/2.2/networks/55555/devices/abcdef"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(deviceURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read body: %v", err)
+				}
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Device.SetNickname(ctx, deviceURL, tc.nickname)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SetNickname() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("Expected *eero.APIError, got %T", err)
+				}
+				if apiErr.HTTPStatusCode != http.StatusNotFound {
+					t.Errorf("Expected status 404, got %d", apiErr.HTTPStatusCode)
+				}
+			}
+		})
+	}
+}
+
+func TestDeviceService_ConnectionHistory(t *testing.T) {
+	t.Parallel()
+
+	deviceURL := "/2.2/networks/55555/devices/abcdef"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(deviceURL+"/connection_history", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{
+					"timestamp": "2024-03-01T08:00:00+0000",
+					"event": "connected",
+					"from": null,
+					"to": {"location": "Office", "url": "/2.2/eeros/1"},
+					"frequency": 5,
+					"signal": "-48 dBm"
+				},
+				{
+					"timestamp": "2024-03-01T09:15:30Z",
+					"event": "roamed",
+					"from": {"location": "Office", "url": "/2.2/eeros/1"},
+					"to": {"location": "Kitchen", "url": "/2.2/eeros/2"}
+				}
+			]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := client.Device.ConnectionHistory(ctx, deviceURL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+
+	first := events[0]
+	wantFirst := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	if !first.Timestamp.Equal(wantFirst) {
+		t.Errorf("Expected first timestamp %v, got %v", wantFirst, first.Timestamp.Time)
+	}
+	if first.From != nil {
+		t.Errorf("Expected nil From on initial connect, got %+v", first.From)
+	}
+	if first.To == nil || first.To.Location != "Office" {
+		t.Errorf("Expected To Office, got %+v", first.To)
+	}
+	if first.Frequency == nil || *first.Frequency != 5 {
+		t.Errorf("Expected frequency 5, got %v", first.Frequency)
+	}
+
+	roam := events[1]
+	wantRoam := time.Date(2024, 3, 1, 9, 15, 30, 0, time.UTC)
+	if !roam.Timestamp.Equal(wantRoam) {
+		t.Errorf("Expected roam timestamp %v, got %v", wantRoam, roam.Timestamp.Time)
+	}
+	if roam.Event != "roamed" {
+		t.Errorf("Expected event roamed, got %s", roam.Event)
+	}
+	if roam.From == nil || roam.From.Location != "Office" || roam.To == nil || roam.To.Location != "Kitchen" {
+		t.Errorf("Expected Office -> Kitchen, got %+v -> %+v", roam.From, roam.To)
+	}
+	if roam.Signal != nil {
+		t.Errorf("Expected nil signal, got %v", *roam.Signal)
+	}
+}
+
+func TestDeviceService_Block(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		block        bool
+		mockStatus   int
+		mockResponse string
+		expectBody   string
+		wantErr      bool
+	}{
+		{
+			name:         "Success_Block",
+			block:        true,
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"blacklisted":true}`,
+		},
+		{
+			name:         "Success_Unblock",
+			block:        false,
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"blacklisted":false}`,
+		},
+		{
+			name:         "Failure_UnknownDevice",
+			block:        true,
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "error.device.notFound"}, "data": {}}`,
+			expectBody:   `{"blacklisted":true}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceURL := "/2.2/networks/55555/devices/abcdef"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(deviceURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read body: %v", err)
+				}
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			call := client.Device.Unblock
+			if tc.block {
+				call = client.Device.Block
+			}
+
+			// Calling twice verifies the operation is idempotent.
+			for i := 0; i < 2; i++ {
+				err := call(ctx, deviceURL)
+				if (err != nil) != tc.wantErr {
+					t.Fatalf("call %d: error = %v, wantErr %v", i+1, err, tc.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestDeviceService_ListPage(t *testing.T) {
+	t.Parallel()
+
+	// Five devices served two at a time; cursors are the next start index.
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345/devices", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if limit == 0 {
+			limit = 2
+		}
+		start, _ := strconv.Atoi(q.Get("cursor"))
+		end := start + limit
+		if end > 5 {
+			end = 5
+		}
+		var items []string
+		for i := start; i < end; i++ {
+			items = append(items, fmt.Sprintf(`{"mac": "aa:bb:cc:dd:ee:0%d"}`, i))
+		}
+		next := ""
+		if end < 5 {
+			next = strconv.Itoa(end)
+		}
+		fmt.Fprintf(w, `{"meta": {"code": 200, "next_cursor": %q}, "data": [%s]}`, next, strings.Join(items, ","))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	page, next, err := client.Device.ListPage(ctx, "/2.2/networks/12345", eero.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListPage() error = %v", err)
+	}
+	if len(page) != 2 || next != "2" {
+		t.Fatalf("Expected 2 devices and cursor \"2\", got %d and %q", len(page), next)
+	}
+
+	page, next, err = client.Device.ListPage(ctx, "/2.2/networks/12345", eero.ListOptions{Limit: 10, Cursor: "2"})
+	if err != nil {
+		t.Fatalf("ListPage() error = %v", err)
+	}
+	if len(page) != 3 || next != "" || page[0].MAC != "aa:bb:cc:dd:ee:02" {
+		t.Fatalf("Expected last page of 3 devices from index 2, got %+v (cursor %q)", page, next)
+	}
+
+	if _, _, err := client.Device.ListPage(ctx, "/2.2/networks/12345", eero.ListOptions{Limit: -1}); !errors.Is(err, eero.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for a negative limit, got %v", err)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	var macs []string
+	err = client.Device.ListAll(ctx, "/2.2/networks/12345", func(d eero.Device) error {
+		macs = append(macs, d.MAC)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	// DefaultDevicePageSize exceeds the network size, so one page suffices.
+	if len(macs) != 5 || atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("Expected 5 devices in 1 request, got %v in %d", macs, atomic.LoadInt32(&requests))
+	}
+
+	stop := errors.New("stop")
+	seen := 0
+	err = client.Device.ListAll(ctx, "/2.2/networks/12345", func(d eero.Device) error {
+		seen++
+		if seen == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || seen != 3 {
+		t.Errorf("Expected ListAll to stop with the callback error after 3 devices, got %v after %d", err, seen)
+	}
+}
+
+func TestDeviceService_ListAll_WalksPages(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345/devices", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"meta": {"code": 200, "next_cursor": "p2"}, "data": [{"mac": "aa:bb:cc:dd:ee:01"}, {"mac": "aa:bb:cc:dd:ee:02"}]}`))
+		case "p2":
+			_, _ = w.Write([]byte(`{"meta": {"code": 200, "next_cursor": "p3"}, "data": [{"mac": "aa:bb:cc:dd:ee:03"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [{"mac": "aa:bb:cc:dd:ee:04"}]}`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	count := 0
+	if err := client.Device.ListAll(context.Background(), "/2.2/networks/12345", func(eero.Device) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	if count != 4 || atomic.LoadInt32(&requests) != 3 {
+		t.Errorf("Expected 4 devices over 3 pages, got %d over %d", count, atomic.LoadInt32(&requests))
+	}
+}
+
+func TestDeviceService_ListLenient(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345/devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [
+			{"mac": "aa:bb:cc:dd:ee:01", "last_active": "2026-02-21T22:14:52Z"},
+			{"mac": "aa:bb:cc:dd:ee:02", "last_active": "last tuesday"},
+			{"mac": "aa:bb:cc:dd:ee:03", "connected": "yes"},
+			{"mac": "aa:bb:cc:dd:ee:04"}
+		]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	if _, err := client.Device.List(ctx, "/2.2/networks/12345"); err == nil {
+		t.Fatal("Expected the strict List to fail on malformed entries")
+	}
+
+	devices, decodeErrs, err := client.Device.ListLenient(ctx, "/2.2/networks/12345")
+	if err != nil {
+		t.Fatalf("ListLenient() error = %v", err)
+	}
+	if len(devices) != 2 || devices[0].MAC != "aa:bb:cc:dd:ee:01" || devices[1].MAC != "aa:bb:cc:dd:ee:04" {
+		t.Errorf("Expected devices 01 and 04, got %+v", devices)
+	}
+	if len(decodeErrs) != 2 {
+		t.Fatalf("Expected 2 decode errors, got %d", len(decodeErrs))
+	}
+	if e := decodeErrs[0]; e.Index != 1 || e.MAC != "aa:bb:cc:dd:ee:02" || !strings.Contains(string(e.Raw), "last tuesday") {
+		t.Errorf("Unexpected first decode error: %+v", e)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if e := decodeErrs[1]; e.Index != 2 || !errors.As(e, &typeErr) {
+		t.Errorf("Expected entry 2 to unwrap to *json.UnmarshalTypeError, got %+v", e)
+	}
+	if msg := decodeErrs[0].Error(); !strings.Contains(msg, "entry 1") || !strings.Contains(msg, "aa:bb:cc:dd:ee:02") {
+		t.Errorf("Unexpected error message %q", msg)
+	}
+}
+
+func TestDeviceService_WaitForOnline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		lists       []string // per-poll device arrays; the last one repeats
+		mac         string
+		poll        time.Duration
+		timeout     time.Duration
+		wantErr     error
+		wantErrText string
+		wantPolls   int32
+	}{
+		{
+			name: "Success_CaseInsensitiveMAC",
+			lists: []string{
+				`[]`,
+				`[{"mac": "aa:bb:cc:dd:ee:01", "connected": false}]`,
+				`[{"mac": "aa:bb:cc:dd:ee:01", "connected": true}]`,
+			},
+			mac:       "AA:BB:CC:DD:EE:01",
+			poll:      time.Millisecond,
+			timeout:   2 * time.Second,
+			wantPolls: 3,
+		},
+		{
+			name:        "Timeout_NeverAppears",
+			lists:       []string{`[{"mac": "aa:bb:cc:dd:ee:02", "connected": true}]`},
+			mac:         "aa:bb:cc:dd:ee:01",
+			poll:        time.Millisecond,
+			timeout:     50 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded,
+			wantErrText: "device not seen",
+		},
+		{
+			name:        "Timeout_StaysDisconnected",
+			lists:       []string{`[{"mac": "aa:bb:cc:dd:ee:01", "connected": false}]`},
+			mac:         "aa:bb:cc:dd:ee:01",
+			poll:        time.Millisecond,
+			timeout:     50 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded,
+			wantErrText: "not connected",
+		},
+		{
+			name:    "InvalidPoll",
+			lists:   []string{`[]`},
+			mac:     "aa:bb:cc:dd:ee:01",
+			timeout: time.Second,
+			wantErr: eero.ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var polls atomic.Int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/12345/devices", func(w http.ResponseWriter, r *http.Request) {
+				n := int(polls.Add(1)) - 1
+				if n >= len(tc.lists) {
+					n = len(tc.lists) - 1
+				}
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": ` + tc.lists[n] + `}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			device, err := client.Device.WaitForOnline(ctx, "/2.2/networks/12345", tc.mac, tc.poll)
+
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("Expected error wrapping %v, got: %v", tc.wantErr, err)
+				}
+				if !strings.Contains(err.Error(), tc.wantErrText) {
+					t.Errorf("Expected error to contain %q, got: %v", tc.wantErrText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WaitForOnline() error = %v", err)
+			}
+			if device.MAC != "aa:bb:cc:dd:ee:01" || !device.Connected {
+				t.Errorf("Unexpected device %+v", device)
+			}
+			if got := polls.Load(); got != tc.wantPolls {
+				t.Errorf("Expected %d polls, got %d", tc.wantPolls, got)
+			}
+		})
+	}
+}
+
+func TestDeviceService_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockStatus   int
+		mockResponse string
+		wantErr      bool
+		validate     func(t *testing.T, d *eero.Device)
+	}{
+		{
+			name:       "Success_SingleDevice",
+			mockStatus: http.StatusOK,
+			mockResponse: `{
+				"meta": {"code": 200},
+				"data": {
+					"url": "/2.2/networks/55555/devices/abcdef",
+					"mac": "AA:BB:CC:DD:EE:FF",
+					"connected": true,
+					"connectivity": {"score": 0.87, "score_bars": 4}
+				}
+			}`,
+			validate: func(t *testing.T, d *eero.Device) {
+				if d.MAC != "AA:BB:CC:DD:EE:FF" {
+					t.Errorf("Expected MAC AA:BB:CC:DD:EE:FF, got %s", d.MAC)
+				}
+				if !d.Connected {
+					t.Error("Expected Connected to be true")
+				}
+				if d.Connectivity.Score != 0.87 || d.Connectivity.ScoreBars != 4 {
+					t.Errorf("Unexpected connectivity: %+v", d.Connectivity)
+				}
+			},
+		},
+		{
+			name:         "Failure_DeviceRemoved",
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "error.device.notFound"}, "data": {}}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceURL := "/2.2/networks/55555/devices/abcdef"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(deviceURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET, got %s", r.Method)
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			d, err := client.Device.Get(ctx, deviceURL)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != http.StatusNotFound {
+					t.Errorf("Expected 404 *eero.APIError, got %v", err)
+				}
+				return
+			}
+			if tc.validate != nil {
+				tc.validate(t, d)
+			}
+		})
+	}
+}
+
+func TestDeviceService_Pause(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pause        bool
+		mockStatus   int
+		mockResponse string
+		expectBody   string
+		wantErr      bool
+	}{
+		{
+			name:         "Success_Pause",
+			pause:        true,
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"paused":true}`,
+		},
+		{
+			name:         "Success_Unpause",
+			pause:        false,
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+			expectBody:   `{"paused":false}`,
+		},
+		{
+			name:         "Failure_NotPausable",
+			pause:        true,
+			mockStatus:   http.StatusBadRequest,
+			mockResponse: `{"meta": {"code": 400, "error": "error.device.not_pausable"}, "data": {}}`,
+			expectBody:   `{"paused":true}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deviceURL := "/2.2/networks/55555/devices/abcdef"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(deviceURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read body: %v", err)
+				}
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			var err error
+			if tc.pause {
+				err = client.Device.Pause(ctx, deviceURL)
+			} else {
+				err = client.Device.Unpause(ctx, deviceURL)
+			}
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("Expected *eero.APIError, got %T", err)
+				}
+				if apiErr.Message != "error.device.not_pausable" {
+					t.Errorf("Expected server message to be preserved, got %q", apiErr.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestSortDevices(t *testing.T) {
+	t.Parallel()
+
+	at := func(s string) eero.EeroTime {
+		ts, _ := time.Parse(time.RFC3339, s)
+		return eero.EeroTime{Time: ts}
+	}
+	devices := []eero.Device{
+		{MAC: "aa:bb:cc:dd:ee:01", Nickname: ptr("zed"), IP: ptr("192.168.4.10"), LastActive: at("2026-01-01T00:00:00Z"), Connectivity: eero.DeviceConnectivity{Score: 2.5}},
+		{MAC: "aa:bb:cc:dd:ee:02", LastActive: at("2026-03-01T00:00:00Z"), Connectivity: eero.DeviceConnectivity{Score: 5}},
+		{MAC: "aa:bb:cc:dd:ee:03", Nickname: ptr("Alpha"), IP: ptr("192.168.4.9"), LastActive: at("2026-02-01T00:00:00Z")},
+		{MAC: "AA:BB:CC:DD:EE:00", Connectivity: eero.DeviceConnectivity{Score: 5}},
+	}
+
+	tests := []struct {
+		by   eero.DeviceSortField
+		want []string // MAC suffixes in expected order
+	}{
+		{by: eero.SortByLastActive, want: []string{"02", "03", "01", "00"}},
+		{by: eero.SortByNickname, want: []string{"03", "01", "00", "02"}},
+		{by: eero.SortByScore, want: []string{"00", "02", "01", "03"}},
+		{by: eero.SortByIP, want: []string{"03", "01", "00", "02"}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(string(tc.by), func(t *testing.T) {
+			t.Parallel()
+
+			sorted := append([]eero.Device(nil), devices...)
+			eero.SortDevices(sorted, tc.by)
+
+			var got []string
+			for _, d := range sorted {
+				got = append(got, d.MAC[len(d.MAC)-2:])
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("SortDevices(%s) = %v, want %v", tc.by, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeviceService_ListSorted_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	client, _ := eero.NewClient()
+	_, err := client.Device.ListSorted(context.Background(), "/2.2/networks/1", eero.DeviceSortField("color"))
+	if !errors.Is(err, eero.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestDedupeDevices(t *testing.T) {
+	t.Parallel()
+
+	devices := []eero.Device{
+		{URL: "/devices/offline", MAC: "AA:BB:CC:DD:EE:01", Connected: false, Hostname: ptr("laptop"), Manufacturer: ptr("Apple")},
+		{URL: "/devices/other", MAC: "AA:BB:CC:DD:EE:02", Connected: true},
+		{URL: "/devices/online", MAC: "aa-bb-cc-dd-ee-01", Connected: true},
+		{URL: "/devices/sparse", MAC: "AA:BB:CC:DD:EE:02", Connected: true, Hostname: ptr("tv"), IP: ptr("192.168.4.20")},
+		{URL: "/devices/nomac-1"},
+		{URL: "/devices/nomac-2"},
+	}
+
+	got := eero.DedupeDevices(devices)
+
+	want := []string{"/devices/online", "/devices/sparse", "/devices/nomac-1", "/devices/nomac-2"}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d devices, got %d: %+v", len(want), len(got), got)
+	}
+	for i, url := range want {
+		if got[i].URL != url {
+			t.Errorf("Device %d: expected %s, got %s", i, url, got[i].URL)
+		}
+	}
+}
+
+func TestDevice_IPv6Helpers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		addrs         []eero.IPv6Address
+		wantGlobal    string
+		wantLinkLocal string
+	}{
+		{
+			name: "ReportedScopes",
+			addrs: []eero.IPv6Address{
+				{Address: "fd52:1:2:1::10/64", Scope: "ula"},
+				{Address: "fe80::1c2b:3aff:fe4d:5e6f/64", Scope: "link"},
+				{Address: "2600:1700:abcd:1::10/64", Scope: "global"},
+			},
+			wantGlobal:    "2600:1700:abcd:1::10/64",
+			wantLinkLocal: "fe80::1c2b:3aff:fe4d:5e6f/64",
+		},
+		{
+			name: "ScopeInferredFromAddress",
+			addrs: []eero.IPv6Address{
+				{Address: "fd52:1:2:1::10"},
+				{Address: "2600:1700:abcd:1::11"},
+				{Address: "FE80::2"},
+			},
+			wantGlobal:    "2600:1700:abcd:1::11",
+			wantLinkLocal: "FE80::2",
+		},
+		{
+			name: "LinkLocalOnly",
+			addrs: []eero.IPv6Address{
+				{Address: "fe80::3", Scope: "Link-Local"},
+			},
+			wantLinkLocal: "fe80::3",
+		},
+		{
+			name: "NoAddresses",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := eero.Device{IPv6Addresses: tc.addrs}
+			n := eero.EeroNode{IPv6Addresses: tc.addrs}
+
+			for _, c := range []struct {
+				kind string
+				get  func() (string, bool)
+				want string
+			}{
+				{"Device.GlobalIPv6", d.GlobalIPv6, tc.wantGlobal},
+				{"Device.LinkLocalIPv6", d.LinkLocalIPv6, tc.wantLinkLocal},
+				{"EeroNode.GlobalIPv6", n.GlobalIPv6, tc.wantGlobal},
+				{"EeroNode.LinkLocalIPv6", n.LinkLocalIPv6, tc.wantLinkLocal},
+			} {
+				if addr, ok := c.get(); addr != c.want || ok != (c.want != "") {
+					t.Errorf("%s() = %q, %v; want %q", c.kind, addr, ok, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDevice_PrimaryIPAndAllIPs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		device      eero.Device
+		wantPrimary string
+		wantAll     []string
+	}{
+		{
+			name:        "PrefersIP",
+			device:      eero.Device{IP: ptr("192.168.4.20"), IPv4: "192.168.4.21", IPs: []string{"192.168.4.20", "fe80::1"}},
+			wantPrimary: "192.168.4.20",
+			wantAll:     []string{"192.168.4.20", "192.168.4.21", "fe80::1"},
+		},
+		{
+			name:        "NilIPFallsBackToIPv4",
+			device:      eero.Device{IPv4: "192.168.4.21", IPs: []string{"192.168.4.22"}},
+			wantPrimary: "192.168.4.21",
+			wantAll:     []string{"192.168.4.21", "192.168.4.22"},
+		},
+		{
+			name:        "IPv6InIPSkipped",
+			device:      eero.Device{IP: ptr("fe80::1"), IPs: []string{"FE80:0::1", "10.0.0.5"}},
+			wantPrimary: "10.0.0.5",
+			wantAll:     []string{"fe80::1", "10.0.0.5"},
+		},
+		{
+			name:   "Offline",
+			device: eero.Device{IP: ptr("")},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.device.PrimaryIP(); got != tc.wantPrimary {
+				t.Errorf("PrimaryIP() = %q, want %q", got, tc.wantPrimary)
+			}
+			if got := tc.device.AllIPs(); strings.Join(got, ",") != strings.Join(tc.wantAll, ",") {
+				t.Errorf("AllIPs() = %v, want %v", got, tc.wantAll)
+			}
+		})
+	}
+}
+
+func TestDeviceConnectivity_WiFiSummary(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name         string
+		connectivity eero.DeviceConnectivity
+		want         string
+		wantStreams  int
+		wantMCS      *int
+	}{
+		{
+			name: "FullRateInfo",
+			connectivity: eero.DeviceConnectivity{
+				RxRateInfo: eero.RateInfo{
+					MCS:          intPtr(11),
+					NSS:          intPtr(2),
+					ChannelWidth: ptr("WIDTH_80MHz"),
+					PhyType:      ptr("he"),
+				},
+			},
+			want:        "Wi-Fi 6, 2x2, 80MHz, HE",
+			wantStreams: 2,
+			wantMCS:     intPtr(11),
+		},
+		{
+			name: "SparseRateInfo_FallsBackToTx",
+			connectivity: eero.DeviceConnectivity{
+				RxRateInfo: eero.RateInfo{ChannelWidth: ptr("40")},
+				TxRateInfo: eero.RateInfo{PhyType: ptr("VHT")},
+			},
+			want: "Wi-Fi 5, 40MHz, VHT",
+		},
+		{
+			name: "NoRateInfo",
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.connectivity.WiFiSummary()
+			if got.String() != tc.want {
+				t.Errorf("String() = %q, want %q", got.String(), tc.want)
+			}
+			if got.Streams != tc.wantStreams {
+				t.Errorf("Streams = %d, want %d", got.Streams, tc.wantStreams)
+			}
+			if (got.MCS == nil) != (tc.wantMCS == nil) || (got.MCS != nil && *got.MCS != *tc.wantMCS) {
+				t.Errorf("MCS = %v, want %v", got.MCS, tc.wantMCS)
+			}
+		})
+	}
+}
@@ -1,7 +1,100 @@
 // Package eero provides a Go client for the eero router REST API.
 package eero
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrDeviceNotFound is returned by device lookup helpers when no device on the
+// network matches the requested identifier.
+var ErrDeviceNotFound = errors.New("eero: device not found")
+
+// ErrOwnerHidden is returned by NetworkService.OwnerInfo when the
+// authenticated account is not permitted to see the network owner's details
+// (typically a non-owner admin of a shared network).
+var ErrOwnerHidden = errors.New("eero: network owner details are not visible to this account")
+
+// ErrNoNetworks is returned by Client.DefaultNetworkURL when the
+// authenticated account has no networks.
+var ErrNoNetworks = errors.New("eero: account has no networks")
+
+// ErrNoNodes is returned by node lookup helpers such as
+// NetworkDetails.GatewayNode when the network has no eero nodes (e.g. a
+// placeholder network).
+var ErrNoNodes = errors.New("eero: network has no eero nodes")
+
+// ErrNodeOffline is returned by NetworkService.RebootNode when the target
+// eero is not reporting a healthy heartbeat and so cannot receive commands.
+var ErrNodeOffline = errors.New("eero: node is offline")
+
+// ErrBackhaulUnsupported is returned by NetworkService.SetBackhaulPreference
+// when the target node cannot have its backhaul preference changed, such as
+// the gateway (which has no backhaul) or hardware the API rejects.
+var ErrBackhaulUnsupported = errors.New("eero: node does not support a backhaul preference")
+
+// ErrUpdateNotAvailable is returned by NetworkService.StartUpdate when the
+// network reports that no firmware update can be started right now.
+var ErrUpdateNotAvailable = errors.New("eero: firmware update cannot be started now")
+
+// ErrPremiumRequired is returned by methods that change eero Secure / eero
+// Plus features, such as NetworkService.SetAdBlock, when the API refuses the
+// change (HTTP 402 or 403) because the network has no active subscription.
+// The underlying *APIError remains available through errors.As.
+//
+// Any *APIError for which IsPremiumRequired reports true also matches
+// ErrPremiumRequired under errors.Is, whichever method returned it.
+var ErrPremiumRequired = errors.New("eero: an eero Secure or eero Plus subscription is required")
+
+// ErrBridgeMode is returned by operations that need eero to act as the
+// router, such as creating DHCP reservations or port forwards, when the
+// network is in bridge mode (see NetworkDetails.RequireRouterMode).
+var ErrBridgeMode = errors.New("eero: network is in bridge mode")
+
+// ErrNotOwner is returned by AccountService.TransferNetwork and
+// CancelTransfer when the API refuses the request (HTTP 403) because the
+// authenticated account does not own the network (Account.IsOwner is false).
+// Only the owner can hand a network over; ask them to start the transfer.
+var ErrNotOwner = errors.New("eero: only the network owner can transfer it")
+
+// ErrAmazonLoginRequired is matched, via errors.Is, by an *APIError whose
+// message says the account must sign in with Amazon: accounts linked to, or
+// migrated to, Amazon login (see Account.CanMigrateToAmazonLogin) can no
+// longer complete the email/SMS code flow of Auth.Login. The library does
+// not implement the Amazon flow; sign in through the eero app and restore
+// the resulting token with Client.SetSessionCookie instead.
+var ErrAmazonLoginRequired = errors.New("eero: account requires login with Amazon")
+
+// ErrNotBusinessAccount is returned by AccountService.Organization when the
+// authenticated account is not an eero for Business account, i.e. it reports
+// no Account.OrganizationID.
+var ErrNotBusinessAccount = errors.New("eero: account does not belong to an eero for Business organization")
+
+// ErrNoDeadline is returned, before any request is sent, when the client was
+// built with WithRequireContextDeadline and a method is called with a
+// context that has no deadline.
+var ErrNoDeadline = errors.New("eero: context has no deadline")
+
+// ErrInvalidArgument is returned, before any request is sent, when a method
+// argument is outside the range the eero API accepts.
+var ErrInvalidArgument = errors.New("eero: invalid argument")
+
+// ErrConflict is matched, via errors.Is, by an *APIError for HTTP 412
+// (Precondition Failed): a mutation sent with WithIfMatch carried an ETag
+// that no longer matches the resource because someone else changed it.
+// Re-fetch the resource, reapply the change, and try again.
+var ErrConflict = errors.New("eero: resource was modified concurrently")
+
+// ErrFixtureNotFound is returned by a client built with WithReplayer when a
+// request has no recorded fixture.
+var ErrFixtureNotFound = errors.New("eero: no recorded fixture for request")
 
 // APIError represents an error returned by the eero API.
 // Eero responses include a "meta" envelope with a status code and optional
@@ -16,6 +109,15 @@ type APIError struct {
 	Message string `json:"error"`
 	// ServerTime is the server timestamp from the "meta" envelope.
 	ServerTime string `json:"server_time"`
+	// RetryAfter is the delay requested by the response's Retry-After
+	// header (given in seconds or as an HTTP date), or zero when absent.
+	// It is typically set on 429 and 503 responses.
+	RetryAfter time.Duration `json:"-"`
+	// Raw is the complete "meta" object exactly as the server sent it,
+	// including fields the library does not model. It is nil when the body
+	// had no parsable meta or the meta exceeded 16 KiB. Raw is never
+	// included in Error(); use Details to log it deliberately.
+	Raw json.RawMessage `json:"-"`
 }
 
 // Error implements the error interface.
@@ -26,7 +128,131 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("eero: HTTP %d, API code %d", e.HTTPStatusCode, e.Code)
 }
 
+// Details returns the raw "meta" object of the error response (see Raw), for
+// diagnosing undocumented error codes. It returns nil when unavailable.
+func (e *APIError) Details() json.RawMessage {
+	return e.Raw
+}
+
 // IsAuthError reports whether the API error indicates an authentication failure.
 func (e *APIError) IsAuthError() bool {
 	return e.HTTPStatusCode == 401 || e.Code == 401
 }
+
+// IsNotFound reports whether the API error indicates a missing resource.
+func (e *APIError) IsNotFound() bool {
+	return e.HTTPStatusCode == 404 || e.Code == 404
+}
+
+// IsRateLimited reports whether the API error indicates the client is being
+// rate limited. RetryAfter, when non-zero, says how long to back off.
+func (e *APIError) IsRateLimited() bool {
+	return e.HTTPStatusCode == 429 || e.Code == 429
+}
+
+// premiumMessageHints are lowercase fragments of meta error messages that
+// eero uses on 403 responses from subscription-gated endpoints.
+var premiumMessageHints = []string{"premium", "subscription", "eero plus", "eero secure"}
+
+// IsPremiumRequired reports whether the API error indicates that the
+// request needs an eero Secure or eero Plus subscription: HTTP status or
+// meta code 402 (Payment Required), or a 403 whose meta error message refers
+// to a premium subscription.
+func (e *APIError) IsPremiumRequired() bool {
+	if e.HTTPStatusCode == http.StatusPaymentRequired || e.Code == http.StatusPaymentRequired {
+		return true
+	}
+	if e.HTTPStatusCode != http.StatusForbidden && e.Code != http.StatusForbidden {
+		return false
+	}
+	msg := strings.ToLower(e.Message)
+	for _, hint := range premiumMessageHints {
+		if strings.Contains(msg, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAmazonLoginRequired reports whether the API refused a login because the
+// account must authenticate with Amazon, which eero signals through a meta
+// error message mentioning Amazon login.
+func (e *APIError) IsAmazonLoginRequired() bool {
+	msg := strings.ToLower(e.Message)
+	return strings.Contains(msg, "amazon") && strings.Contains(msg, "login")
+}
+
+// IsConflict reports whether the API error indicates a failed If-Match
+// precondition (HTTP 412), i.e. the resource changed since it was read.
+func (e *APIError) IsConflict() bool {
+	return e.HTTPStatusCode == http.StatusPreconditionFailed || e.Code == http.StatusPreconditionFailed
+}
+
+// Is lets errors.Is match an *APIError against ErrPremiumRequired when
+// IsPremiumRequired reports true, against ErrConflict when IsConflict
+// reports true, and against ErrAmazonLoginRequired when
+// IsAmazonLoginRequired reports true.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrPremiumRequired:
+		return e.IsPremiumRequired()
+	case ErrConflict:
+		return e.IsConflict()
+	case ErrAmazonLoginRequired:
+		return e.IsAmazonLoginRequired()
+	}
+	return false
+}
+
+// TransportError reports a failure below the HTTP layer — DNS resolution,
+// TLS handshake, a refused or reset connection, or the client's fallback
+// timeout — where no API response was received. It complements *APIError,
+// which describes responses the API did send. Errors caused by the caller's
+// context are not TransportErrors; they wrap ctx.Err() directly.
+type TransportError struct {
+	// Op is the stage that failed: "executing request" or
+	// "reading response body".
+	Op string
+	// Method is the HTTP method of the failed request.
+	Method string
+	// URL is the request URL, with credentials and token-bearing query
+	// parameters redacted.
+	URL string
+	// Err is the underlying error, typically a *url.Error wrapping a
+	// *net.OpError or *net.DNSError.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("eero: %s: %v", e.Op, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.As can reach the *url.Error
+// or net error beneath.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout reports whether the failure was a timeout, such as a slow TLS
+// handshake or the HTTP client's fallback timeout.
+func (e *TransportError) Timeout() bool {
+	var ne net.Error
+	return errors.As(e.Err, &ne) && ne.Timeout()
+}
+
+// Temporary reports whether the failure is likely transient and worth
+// retrying: a timeout, a refused or reset connection, or a temporary DNS
+// failure. A DNS "no such host" or a certificate error is not temporary.
+func (e *TransportError) Temporary() bool {
+	if e.Timeout() {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(e.Err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	return errors.Is(e.Err, syscall.ECONNREFUSED) ||
+		errors.Is(e.Err, syscall.ECONNRESET) ||
+		errors.Is(e.Err, io.ErrUnexpectedEOF)
+}
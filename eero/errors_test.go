@@ -1,7 +1,17 @@
 package eero_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/arvarik/eero-go/eero"
 )
@@ -80,3 +90,245 @@ func TestAPIError_IsAuthError(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIError_IsPremiumRequired(t *testing.T) {
+	tests := []struct {
+		name string
+		err  eero.APIError
+		want bool
+	}{
+		{
+			name: "HTTP 402",
+			err:  eero.APIError{HTTPStatusCode: 402, Code: 402},
+			want: true,
+		},
+		{
+			name: "API code 402",
+			err:  eero.APIError{HTTPStatusCode: 200, Code: 402},
+			want: true,
+		},
+		{
+			name: "HTTP 403 premium message",
+			err:  eero.APIError{HTTPStatusCode: 403, Code: 403, Message: "error.premium.required"},
+			want: true,
+		},
+		{
+			name: "HTTP 403 unrelated message",
+			err:  eero.APIError{HTTPStatusCode: 403, Code: 403, Message: "Forbidden"},
+			want: false,
+		},
+		{
+			name: "HTTP 400",
+			err:  eero.APIError{HTTPStatusCode: 400, Code: 1001, Message: "subscription field invalid"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.IsPremiumRequired(); got != tt.want {
+				t.Errorf("APIError.IsPremiumRequired() = %v, want %v", got, tt.want)
+			}
+			var err error = fmt.Errorf("wrapped: %w", &tt.err)
+			if got := errors.Is(err, eero.ErrPremiumRequired); got != tt.want {
+				t.Errorf("errors.Is(err, ErrPremiumRequired) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError_IsNotFoundAndIsRateLimited(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             eero.APIError
+		wantNotFound    bool
+		wantRateLimited bool
+	}{
+		{
+			name:         "HTTP 404",
+			err:          eero.APIError{HTTPStatusCode: 404},
+			wantNotFound: true,
+		},
+		{
+			name:         "API code 404",
+			err:          eero.APIError{HTTPStatusCode: 200, Code: 404},
+			wantNotFound: true,
+		},
+		{
+			name:            "HTTP 429",
+			err:             eero.APIError{HTTPStatusCode: 429, Code: 429},
+			wantRateLimited: true,
+		},
+		{
+			name: "HTTP 500",
+			err:  eero.APIError{HTTPStatusCode: 500, Code: 500},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.IsNotFound(); got != tt.wantNotFound {
+				t.Errorf("APIError.IsNotFound() = %v, want %v", got, tt.wantNotFound)
+			}
+			if got := tt.err.IsRateLimited(); got != tt.wantRateLimited {
+				t.Errorf("APIError.IsRateLimited() = %v, want %v", got, tt.wantRateLimited)
+			}
+		})
+	}
+}
+
+func TestAPIError_RetryAfterFromHeader(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"meta": {"code": 429, "error": "Too Many Requests"}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL
+
+	_, err := client.Account.Get(context.Background())
+
+	var apiErr *eero.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if !apiErr.IsRateLimited() {
+		t.Errorf("Expected IsRateLimited, got %+v", apiErr)
+	}
+	if apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("Expected RetryAfter 7s, got %s", apiErr.RetryAfter)
+	}
+	if want := "eero: HTTP 429, API code 429: Too Many Requests"; apiErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", apiErr.Error(), want)
+	}
+}
+
+func TestAPIError_Details(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"meta": {"code": 400, "error": "error.network.invalid", "error_detail": {"field": "ssid", "reason": "reserved"}}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL
+
+	_, err := client.Account.Get(context.Background())
+
+	var apiErr *eero.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+
+	var details struct {
+		ErrorDetail struct {
+			Field string `json:"field"`
+		} `json:"error_detail"`
+	}
+	if err := json.Unmarshal(apiErr.Details(), &details); err != nil {
+		t.Fatalf("Failed to decode Details(): %v (%s)", err, apiErr.Details())
+	}
+	if details.ErrorDetail.Field != "ssid" {
+		t.Errorf("Expected error_detail.field %q, got %q", "ssid", details.ErrorDetail.Field)
+	}
+	if apiErr.Code != 400 || apiErr.Message != "error.network.invalid" {
+		t.Errorf("Unexpected parsed meta: %+v", apiErr)
+	}
+	if strings.Contains(apiErr.Error(), "error_detail") {
+		t.Errorf("Error() must not include raw meta, got %q", apiErr.Error())
+	}
+}
+
+func TestTransportError(t *testing.T) {
+	// A listener that is closed straight away gives a refused connection.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	refusedURL := "http://" + ln.Addr().String()
+	_ = ln.Close()
+
+	hang := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-hang:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(hang)
+
+	tests := []struct {
+		name          string
+		baseURL       string
+		opts          []eero.Option
+		wantTimeout   bool
+		wantTemporary bool
+	}{
+		{name: "ConnectionRefused", baseURL: refusedURL, wantTemporary: true},
+		{name: "ClientTimeout", baseURL: slow.URL, opts: []eero.Option{eero.WithTimeout(20 * time.Millisecond)}, wantTimeout: true, wantTemporary: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := eero.NewClient(tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = tt.baseURL
+
+			_, err = client.Account.Get(context.Background())
+			var te *eero.TransportError
+			if !errors.As(err, &te) {
+				t.Fatalf("Expected a *TransportError, got %T: %v", err, err)
+			}
+			if te.Method != http.MethodGet || !strings.HasSuffix(te.URL, "/account") {
+				t.Errorf("Unexpected request details: %s %s", te.Method, te.URL)
+			}
+			if got := te.Timeout(); got != tt.wantTimeout {
+				t.Errorf("Timeout() = %v, want %v", got, tt.wantTimeout)
+			}
+			if got := te.Temporary(); got != tt.wantTemporary {
+				t.Errorf("Temporary() = %v, want %v", got, tt.wantTemporary)
+			}
+			var apiErr *eero.APIError
+			if errors.As(err, &apiErr) {
+				t.Error("Expected no *APIError for a transport failure")
+			}
+		})
+	}
+}
+
+func TestTransportError_Temporary(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "NoSuchHost", err: &net.DNSError{Err: "no such host", Name: "x.invalid", IsNotFound: true}, want: false},
+		{name: "DNSTemporary", err: &net.DNSError{Err: "server misbehaving", Name: "x", IsTemporary: true}, want: true},
+		{name: "Reset", err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, want: true},
+		{name: "Other", err: errors.New("x509: certificate signed by unknown authority"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			te := &eero.TransportError{Op: "executing request", Err: tt.err}
+			if got := te.Temporary(); got != tt.want {
+				t.Errorf("Temporary() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(te, tt.err) {
+				t.Error("Expected Unwrap to expose the underlying error")
+			}
+			if !strings.HasPrefix(te.Error(), "eero: executing request: ") {
+				t.Errorf("Unexpected message %q", te.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package eero
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Guest network password bounds, matching WPA2 passphrase limits. eero
+// rejects anything outside them with an opaque 400.
+const (
+	minGuestPasswordLen = 8
+	maxGuestPasswordLen = 63
+)
+
+// GuestNetworkAPI is the method set of GuestNetworkService and the type of
+// Client.Guest. See NetworkAPI for its use with fakes.
+type GuestNetworkAPI interface {
+	Get(ctx context.Context, networkURL string) (*GuestNetwork, error)
+	Enable(ctx context.Context, networkURL string) error
+	Disable(ctx context.Context, networkURL string) error
+	SetName(ctx context.Context, networkURL, name string) error
+	SetPassword(ctx context.Context, networkURL, password string) error
+}
+
+// GuestNetworkService manages the guest Wi-Fi network of an eero network.
+type GuestNetworkService struct {
+	client *Client
+}
+
+var _ GuestNetworkAPI = (*GuestNetworkService)(nil)
+
+// guestNetworkRequest is the body for updating guest network settings. Only
+// non-nil fields are sent, so each mutation touches a single setting.
+type guestNetworkRequest struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
+// --- Methods ---
+
+// Get returns the guest network settings for the specified network.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/guestnetwork" suffix is
+// appended automatically.
+func (s *GuestNetworkService) Get(ctx context.Context, networkURL string) (*GuestNetwork, error) {
+	req, err := s.client.newRequestFromURL(ctx, "guest", http.MethodGet, networkURL+"/guestnetwork", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[GuestNetwork]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("guest: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// Enable turns the guest network on.
+func (s *GuestNetworkService) Enable(ctx context.Context, networkURL string) error {
+	enabled := true
+	return s.update(ctx, networkURL, "enable", guestNetworkRequest{Enabled: &enabled})
+}
+
+// Disable turns the guest network off.
+func (s *GuestNetworkService) Disable(ctx context.Context, networkURL string) error {
+	enabled := false
+	return s.update(ctx, networkURL, "disable", guestNetworkRequest{Enabled: &enabled})
+}
+
+// SetName renames the guest network SSID. An empty or whitespace-only name
+// is rejected with ErrInvalidArgument before any request is sent.
+func (s *GuestNetworkService) SetName(ctx context.Context, networkURL, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("guest: set name: %w: name is empty", ErrInvalidArgument)
+	}
+	return s.update(ctx, networkURL, "set name", guestNetworkRequest{Name: &name})
+}
+
+// SetPassword changes the guest network passphrase. Passwords shorter than 8
+// or longer than 63 characters are rejected with ErrInvalidArgument before
+// any request is sent.
+func (s *GuestNetworkService) SetPassword(ctx context.Context, networkURL, password string) error {
+	if len(password) < minGuestPasswordLen {
+		return fmt.Errorf("guest: set password: %w: password must be at least %d characters", ErrInvalidArgument, minGuestPasswordLen)
+	}
+	if len(password) > maxGuestPasswordLen {
+		return fmt.Errorf("guest: set password: %w: password must be at most %d characters", ErrInvalidArgument, maxGuestPasswordLen)
+	}
+	return s.update(ctx, networkURL, "set password", guestNetworkRequest{Password: &password})
+}
+
+func (s *GuestNetworkService) update(ctx context.Context, networkURL, op string, body guestNetworkRequest) error {
+	req, err := s.client.newRequestFromURL(ctx, "guest", http.MethodPut, networkURL+"/guestnetwork", body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("guest: %s: %w", op, err)
+	}
+
+	return nil
+}
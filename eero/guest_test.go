@@ -0,0 +1,160 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestGuestNetworkService_Get(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555/guestnetwork", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": {
+				"url": "/2.2/networks/55555/guestnetwork",
+				"name": "Visitors",
+				"enabled": true,
+				"password": "welcome123"
+			}
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	guest, err := client.Guest.Get(ctx, "/2.2/networks/55555")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if guest.Name != "Visitors" || !guest.Enabled {
+		t.Errorf("Unexpected guest network: %+v", guest)
+	}
+	if guest.Password == nil || *guest.Password != "welcome123" {
+		t.Errorf("Expected password welcome123, got %v", guest.Password)
+	}
+}
+
+func TestGuestNetworkService_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error
+		expectBody  string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name: "Success_Enable",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.Enable(ctx, networkURL)
+			},
+			expectBody: `{"enabled":true}`,
+		},
+		{
+			name: "Success_Disable",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.Disable(ctx, networkURL)
+			},
+			expectBody: `{"enabled":false}`,
+		},
+		{
+			name: "Success_SetName",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.SetName(ctx, networkURL, "Visitors")
+			},
+			expectBody: `{"name":"Visitors"}`,
+		},
+		{
+			name: "Success_SetPassword",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.SetPassword(ctx, networkURL, "welcome123")
+			},
+			expectBody: `{"password":"welcome123"}`,
+		},
+		{
+			name: "Failure_PasswordTooShort",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.SetPassword(ctx, networkURL, "short")
+			},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name: "Failure_EmptyName",
+			call: func(ctx context.Context, g eero.GuestNetworkAPI, networkURL string) error {
+				return g.SetName(ctx, networkURL, "  ")
+			},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/55555/guestnetwork", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("Failed to read body: %v", err)
+				}
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := tc.call(ctx, client.Guest, "/2.2/networks/55555")
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&hits); n != 0 {
+					t.Errorf("Expected no request to be sent, got %d", n)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package eero
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NetworkHandle binds the network-scoped operations of the client's
+// services to a single network URL, so multi-network code does not have to
+// thread the URL through every call. It is a thin wrapper: each method calls
+// the corresponding service method with the bound URL.
+type NetworkHandle struct {
+	client *Client
+	url    string
+}
+
+// NetworkHandle returns a handle for the network at networkURL, the exact
+// relative URL from the account response (e.g., "/2.2/networks/12345"). A
+// URL not of the form "/{version}/networks/{id}" with a numeric id returns
+// an error wrapping ErrInvalidArgument.
+func (c *Client) NetworkHandle(networkURL string) (*NetworkHandle, error) {
+	if !isNetworkURL(networkURL) {
+		return nil, fmt.Errorf("network handle: %w: %q is not a network URL like /2.2/networks/12345", ErrInvalidArgument, networkURL)
+	}
+	return &NetworkHandle{client: c, url: networkURL}, nil
+}
+
+// isNetworkURL reports whether s has the shape "/{version}/networks/{id}",
+// where version is digits and dots and id is digits.
+func isNetworkURL(s string) bool {
+	parts := strings.Split(s, "/")
+	if len(parts) != 4 || parts[0] != "" || parts[2] != "networks" {
+		return false
+	}
+	return isDigits(strings.ReplaceAll(parts[1], ".", "")) && isDigits(parts[3])
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// URL returns the network URL the handle is bound to.
+func (h *NetworkHandle) URL() string {
+	return h.url
+}
+
+// Get calls NetworkService.Get for the bound network.
+func (h *NetworkHandle) Get(ctx context.Context) (*NetworkDetails, error) {
+	return h.client.Network.Get(ctx, h.url)
+}
+
+// Devices calls DeviceService.List for the bound network.
+func (h *NetworkHandle) Devices(ctx context.Context) ([]Device, error) {
+	return h.client.Device.List(ctx, h.url)
+}
+
+// Profiles calls ProfileService.List for the bound network.
+func (h *NetworkHandle) Profiles(ctx context.Context) ([]Profile, error) {
+	return h.client.Profile.List(ctx, h.url)
+}
+
+// Reboot calls NetworkService.Reboot for the bound network.
+func (h *NetworkHandle) Reboot(ctx context.Context) error {
+	return h.client.Network.Reboot(ctx, h.url)
+}
@@ -0,0 +1,105 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestClient_NetworkHandle_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "/2.2/networks/12345"},
+		{url: "/3/networks/1"},
+		{url: "", wantErr: true},
+		{url: "12345", wantErr: true},
+		{url: "/2.2/networks/", wantErr: true},
+		{url: "/2.2/networks/abc", wantErr: true},
+		{url: "/2.2/networks/12345/devices", wantErr: true},
+		{url: "/2.2/eeros/12345", wantErr: true},
+		{url: "https://api-user.e2ro.com/2.2/networks/12345", wantErr: true},
+	}
+
+	client, _ := eero.NewClient()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.url, func(t *testing.T) {
+			t.Parallel()
+			h, err := client.NetworkHandle(tc.url)
+			if tc.wantErr {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NetworkHandle() error = %v", err)
+			}
+			if h.URL() != tc.url {
+				t.Errorf("Expected URL %s, got %s", tc.url, h.URL())
+			}
+		})
+	}
+}
+
+func TestNetworkHandle_Methods(t *testing.T) {
+	t.Parallel()
+
+	var reboots int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/12345", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "/2.2/networks/12345", "name": "Home"}}`))
+	})
+	mux.HandleFunc("/2.2/networks/12345/devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [{"mac": "aa:bb:cc:dd:ee:01"}, {"mac": "aa:bb:cc:dd:ee:02"}]}`))
+	})
+	mux.HandleFunc("/2.2/networks/12345/profiles", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [{"url": "/2.2/networks/12345/profiles/1", "name": "Kids"}]}`))
+	})
+	mux.HandleFunc("/2.2/networks/12345/reboot", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		atomic.AddInt32(&reboots, 1)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	h, err := client.NetworkHandle("/2.2/networks/12345")
+	if err != nil {
+		t.Fatalf("NetworkHandle() error = %v", err)
+	}
+
+	details, err := h.Get(ctx)
+	if err != nil || details.Name != "Home" {
+		t.Errorf("Get() = %+v, %v", details, err)
+	}
+	devices, err := h.Devices(ctx)
+	if err != nil || len(devices) != 2 {
+		t.Errorf("Devices() = %d devices, %v", len(devices), err)
+	}
+	profiles, err := h.Profiles(ctx)
+	if err != nil || len(profiles) != 1 || profiles[0].Name != "Kids" {
+		t.Errorf("Profiles() = %+v, %v", profiles, err)
+	}
+	if err := h.Reboot(ctx); err != nil {
+		t.Errorf("Reboot() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&reboots); got != 1 {
+		t.Errorf("Expected 1 reboot, got %d", got)
+	}
+}
@@ -2,16 +2,67 @@ package eero
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 )
 
+// NetworkAPI is the method set of NetworkService and the type of
+// Client.Network. Code written against it, rather than the concrete
+// service, can be tested with a fake instead of an HTTP server. A fake
+// assigned to Client.Network is also used by the client itself, for
+// example by Snapshot and NetworkHandle. Embedding the interface in the
+// fake lets it implement only the methods a test needs. Each service has
+// a matching interface; see NetworkService for what the methods do.
+type NetworkAPI interface {
+	Get(ctx context.Context, networkURL string) (*NetworkDetails, error)
+	GetWithMeta(ctx context.Context, networkURL string) (*NetworkDetails, Meta, error)
+	Health(ctx context.Context, networkURL string) (*Health, error)
+	Reboot(ctx context.Context, networkURL string) error
+	RebootAndWait(ctx context.Context, networkURL string, opts ...WaitOption) error
+	RebootNode(ctx context.Context, eeroURL string) error
+	SetBackhaulPreference(ctx context.Context, eeroURL string, preferWired bool) error
+	SetNodeLocation(ctx context.Context, eeroURL, location string) error
+	GetNode(ctx context.Context, eeroURL string) (*EeroNode, error)
+	UpdateStatus(ctx context.Context, networkURL string) (*NetworkUpdates, error)
+	StartUpdate(ctx context.Context, networkURL string) error
+	StartSpeedTest(ctx context.Context, networkURL string) (*SpeedTestJob, error)
+	GetSpeedTest(ctx context.Context, jobURL string) (*NetworkSpeed, error)
+	OwnerInfo(ctx context.Context, networkURL string) (*OwnerInfo, error)
+	GetMTU(ctx context.Context, networkURL string) (int, error)
+	SetMTU(ctx context.Context, networkURL string, mtu int) error
+	ListForwards(ctx context.Context, networkURL string) ([]ForwardRule, error)
+	CreateForward(ctx context.Context, networkURL string, rule ForwardRule) (*ForwardRule, error)
+	DeleteForward(ctx context.Context, forwardURL string) error
+	SetName(ctx context.Context, networkURL, name string) error
+	SetUPnP(ctx context.Context, networkURL string, enabled bool) error
+	SetSQM(ctx context.Context, networkURL string, enabled bool) error
+	SetBandSteering(ctx context.Context, networkURL string, enabled bool) error
+	SetIPv6Upstream(ctx context.Context, networkURL string, enabled bool) error
+	SetWPA3(ctx context.Context, networkURL string, enabled bool) (warning string, err error)
+	SetAdBlock(ctx context.Context, networkURL string, enabled bool) error
+	SetMalwareBlock(ctx context.Context, networkURL string, enabled bool) error
+	SetDNS(ctx context.Context, networkURL string, servers []string) error
+	ResetDNS(ctx context.Context, networkURL string) error
+	PauseAll(ctx context.Context, networkURL string) error
+	ResumeAll(ctx context.Context, networkURL string) error
+	DataUsage(ctx context.Context, networkURL string, window string) (*DataUsage, error)
+}
+
 // NetworkService provides access to eero network configuration and lifecycle.
 type NetworkService struct {
 	client *Client
 }
 
+var _ NetworkAPI = (*NetworkService)(nil)
+
 // --- Response types ---
 
 // NetworkDetails represents the full details of an eero network, including
@@ -52,6 +103,24 @@ type NetworkDetails struct {
 	GuestNetwork   GuestNetwork          `json:"guest_network"`
 	PremiumDetails NetworkPremiumDetails `json:"premium_details"`
 	WanType        string                `json:"wan_type"`
+
+	// ETag is the entity tag the API sent with this resource, or empty if
+	// none. Pass it to WithIfMatch to make a later update conditional.
+	ETag string `json:"-"`
+
+	// NotModified reports that the API answered 304 Not Modified and the
+	// details were decoded from the previous response (see
+	// WithConditionalRequests).
+	NotModified bool `json:"-"`
+}
+
+// OwnerInfo identifies the owner of a network. Owners may choose not to
+// share every contact field, so all of them are optional.
+type OwnerInfo struct {
+	URL   *string `json:"url"`
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+	Phone *string `json:"phone"`
 }
 
 // NetworkConnection describes the router connection mode.
@@ -59,6 +128,53 @@ type NetworkConnection struct {
 	Mode string `json:"mode"`
 }
 
+// ConnectionMode is the normalized form of NetworkConnection.Mode, which
+// decides whether the eero network routes traffic itself.
+type ConnectionMode string
+
+// Connection modes returned by NetworkDetails.ConnectionModeTyped.
+const (
+	// ConnectionModeRouter: eero is the router (NAT and DHCP), with its WAN
+	// address obtained automatically. Reported by eero as "automatic".
+	ConnectionModeRouter ConnectionMode = "router"
+	// ConnectionModeManual: eero is the router with a manually configured
+	// WAN (static IP or PPPoE).
+	ConnectionModeManual ConnectionMode = "manual"
+	// ConnectionModeBridge: another router handles NAT and DHCP; eero only
+	// provides Wi-Fi. DHCP settings, reservations and port forwarding are
+	// unavailable.
+	ConnectionModeBridge ConnectionMode = "bridge"
+	// ConnectionModeUnknown is returned for empty or unrecognized modes.
+	ConnectionModeUnknown ConnectionMode = "unknown"
+)
+
+// ConnectionModeTyped maps the raw Connection.Mode string onto one of the
+// ConnectionMode constants.
+func (n *NetworkDetails) ConnectionModeTyped() ConnectionMode {
+	switch strings.ToLower(n.Connection.Mode) {
+	case "automatic", "auto", "dhcp", "router":
+		return ConnectionModeRouter
+	case "manual", "static", "pppoe":
+		return ConnectionModeManual
+	case "bridge", "bridged":
+		return ConnectionModeBridge
+	default:
+		return ConnectionModeUnknown
+	}
+}
+
+// RequireRouterMode returns an error wrapping ErrBridgeMode when the network
+// is in bridge mode, where the features that depend on eero routing —
+// DHCP settings, DHCP reservations (ReservationService) and port forwarding
+// (NetworkService.CreateForward) — are unavailable. It returns nil for the
+// router, manual and unknown modes.
+func (n *NetworkDetails) RequireRouterMode() error {
+	if n.ConnectionModeTyped() == ConnectionModeBridge {
+		return ErrBridgeMode
+	}
+	return nil
+}
+
 // GeoIP holds geographical settings associated with the network's public IP.
 type GeoIP struct {
 	CountryCode string `json:"countryCode"`
@@ -132,11 +248,13 @@ type NetworkUpdates struct {
 	ManifestResource    string    `json:"manifest_resource"`
 }
 
-// GuestNetwork holds the guest network settings.
+// GuestNetwork holds the guest network settings. Password is only populated
+// by GuestNetworkService.Get; the network details response omits it.
 type GuestNetwork struct {
-	URL     string `json:"url"`
-	Name    string `json:"name"`
-	Enabled bool   `json:"enabled"`
+	URL      string  `json:"url"`
+	Name     string  `json:"name"`
+	Enabled  bool    `json:"enabled"`
+	Password *string `json:"password"`
 }
 
 // IPSettings is the networking configuration of IP allocations.
@@ -147,11 +265,33 @@ type IPSettings struct {
 
 // PremiumDNS tells us if eero Secure filtering is currently in use.
 type PremiumDNS struct {
-	DNSPoliciesEnabled           bool            `json:"dns_policies_enabled"`
-	ZscalerLocationEnabled       bool            `json:"zscaler_location_enabled"`
-	AnyPoliciesEnabledForNetwork bool            `json:"any_policies_enabled_for_network"`
-	DNSPolicies                  DNSPolicies     `json:"dns_policies"`
-	AdBlockSettings              AdBlockSettings `json:"ad_block_settings"`
+	DNSPoliciesEnabled           bool             `json:"dns_policies_enabled"`
+	ZscalerLocationEnabled       bool             `json:"zscaler_location_enabled"`
+	ZscalerLocation              *ZscalerLocation `json:"zscaler_location"`
+	AnyPoliciesEnabledForNetwork bool             `json:"any_policies_enabled_for_network"`
+	DNSPolicies                  DNSPolicies      `json:"dns_policies"`
+	AdBlockSettings              AdBlockSettings  `json:"ad_block_settings"`
+}
+
+// ZscalerLocation describes the Zscaler location eero Secure forwards DNS
+// to when enterprise filtering is enabled on the network. It is nil when the
+// API omits it.
+type ZscalerLocation struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Country     string   `json:"country"`
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// Zscaler returns the network's Zscaler location and true when Zscaler
+// filtering is enabled and the API reported its details. It returns nil and
+// false otherwise, including when ZscalerLocationEnabled is true but no
+// details were sent.
+func (p *PremiumDNS) Zscaler() (*ZscalerLocation, bool) {
+	if p == nil || !p.ZscalerLocationEnabled || p.ZscalerLocation == nil {
+		return nil, false
+	}
+	return p.ZscalerLocation, true
 }
 
 // DNSPolicies determines whether advanced blockers map through.
@@ -167,11 +307,11 @@ type AdBlockSettings struct {
 
 // NetworkPremiumDetails carries subscription context on an associated network.
 type NetworkPremiumDetails struct {
-	HasPaymentInfo   bool   `json:"has_payment_info"`
-	Tier             string `json:"tier"`
-	PaymentMethod    string `json:"payment_method"`
-	Interval         string `json:"interval"`
-	IsMySubscription bool   `json:"is_my_subscription"`
+	HasPaymentInfo   bool        `json:"has_payment_info"`
+	Tier             PremiumTier `json:"tier"`
+	PaymentMethod    string      `json:"payment_method"`
+	Interval         string      `json:"interval"`
+	IsMySubscription bool        `json:"is_my_subscription"`
 }
 
 // IPv6Lease gives a broader upstream prefix context given from an ISP.
@@ -194,6 +334,115 @@ type NetworkEeros struct {
 	Data  []EeroNode `json:"data"`
 }
 
+// HasNodes reports whether the network has any eero nodes. Placeholder and
+// cloud-only networks on an account report Eeros.Count == 0 and no node
+// data; node-targeted helpers return ErrNoNodes for them.
+func (n *NetworkDetails) HasNodes() bool {
+	return n != nil && len(n.Eeros.Data) > 0
+}
+
+// GatewayNode returns the network's gateway node. If no node is flagged as the
+// gateway, the primary node is returned instead. An error wrapping ErrNoNodes
+// is returned when the network has no nodes, and one wrapping
+// ErrDeviceNotFound when none of its nodes is a gateway or primary node.
+func (n *NetworkDetails) GatewayNode() (*EeroNode, error) {
+	if !n.HasNodes() {
+		return nil, fmt.Errorf("network: gateway node: %w", ErrNoNodes)
+	}
+	for i := range n.Eeros.Data {
+		if n.Eeros.Data[i].Gateway {
+			return &n.Eeros.Data[i], nil
+		}
+	}
+	for i := range n.Eeros.Data {
+		if n.Eeros.Data[i].IsPrimaryNode {
+			return &n.Eeros.Data[i], nil
+		}
+	}
+	return nil, fmt.Errorf("network: gateway node: %w", ErrDeviceNotFound)
+}
+
+// ExtenderNodes returns every node except the one GatewayNode selects, in
+// their original order. It returns nil for a network without nodes and all
+// nodes when none can be identified as the gateway.
+func (n *NetworkDetails) ExtenderNodes() []EeroNode {
+	if !n.HasNodes() {
+		return nil
+	}
+	gw, _ := n.GatewayNode()
+	extenders := make([]EeroNode, 0, len(n.Eeros.Data))
+	for i := range n.Eeros.Data {
+		if &n.Eeros.Data[i] != gw {
+			extenders = append(extenders, n.Eeros.Data[i])
+		}
+	}
+	return extenders
+}
+
+// Node returns the node whose URL matches eeroURL. An error wrapping
+// ErrNoNodes is returned when the network has no nodes, and one wrapping
+// ErrDeviceNotFound when no node matches.
+func (n *NetworkDetails) Node(eeroURL string) (*EeroNode, error) {
+	if !n.HasNodes() {
+		return nil, fmt.Errorf("network: node %q: %w", eeroURL, ErrNoNodes)
+	}
+	for i := range n.Eeros.Data {
+		if n.Eeros.Data[i].URL == eeroURL {
+			return &n.Eeros.Data[i], nil
+		}
+	}
+	return nil, fmt.Errorf("network: node %q: %w", eeroURL, ErrDeviceNotFound)
+}
+
+// NodeForDevice returns the node device d is connected through, matched by
+// d.Source.URL or, failing that, by serial number. It reports false when d
+// has no source or its node is not in n.Eeros.Data.
+func (n *NetworkDetails) NodeForDevice(d Device) (*EeroNode, bool) {
+	src := d.Source
+	for i := range n.Eeros.Data {
+		node := &n.Eeros.Data[i]
+		if (src.URL != "" && node.URL == src.URL) || (src.SerialNumber != "" && node.Serial == src.SerialNumber) {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+// SpeedTestJob is a handle to a speed test started with
+// NetworkService.StartSpeedTest. Its URL is polled with
+// NetworkService.GetSpeedTest to retrieve progress and results.
+type SpeedTestJob struct {
+	URL    string `json:"url"`
+	Status string `json:"status"`
+}
+
+// SpeedTestStatus is the normalized state of a speed test.
+type SpeedTestStatus string
+
+// Speed test states reported by NetworkSpeed.TestStatus.
+const (
+	SpeedTestRunning  SpeedTestStatus = "running"
+	SpeedTestComplete SpeedTestStatus = "complete"
+	SpeedTestFailed   SpeedTestStatus = "failed"
+	SpeedTestUnknown  SpeedTestStatus = "unknown"
+)
+
+// TestStatus maps the raw Status string reported by eero onto one of the
+// SpeedTest* constants. eero has used several spellings over time (e.g.
+// "in_progress", "completed"), all of which are folded together here.
+func (s *NetworkSpeed) TestStatus() SpeedTestStatus {
+	switch strings.ToLower(s.Status) {
+	case "running", "in_progress", "pending", "queued", "started":
+		return SpeedTestRunning
+	case "complete", "completed", "done", "success", "succeeded":
+		return SpeedTestComplete
+	case "failed", "failure", "error", "cancelled", "canceled":
+		return SpeedTestFailed
+	default:
+		return SpeedTestUnknown
+	}
+}
+
 // SpeedMeasurement is a single directional speed measurement.
 type SpeedMeasurement struct {
 	Value float64 `json:"value"`
@@ -212,6 +461,16 @@ type InternetHealth struct {
 	ISPUp  bool   `json:"isp_up"`
 }
 
+// IsHealthy reports whether the ISP is up and both the internet and the eero
+// mesh report a good status ("green" or "good", case-insensitive).
+func (h *Health) IsHealthy() bool {
+	return h.Internet.ISPUp && isGoodHealthStatus(h.Internet.Status) && isGoodHealthStatus(h.EeroNetwork.Status)
+}
+
+func isGoodHealthStatus(status string) bool {
+	return strings.EqualFold(status, "green") || strings.EqualFold(status, "good")
+}
+
 // HealthDetail is a single health metric.
 type HealthDetail struct {
 	Status string `json:"status"`
@@ -248,6 +507,24 @@ type EeroNode struct {
 	Bands                 []string      `json:"bands"`
 	ProvidesWifi          bool          `json:"provides_wifi"`
 	State                 string        `json:"state"`
+	// Telemetry is nil for models that do not report hardware metrics.
+	Telemetry *NodeTelemetry `json:"telemetry"`
+}
+
+// NodeTelemetry holds hardware metrics reported by a node, useful for
+// thermal and placement troubleshooting. Models report different subsets,
+// so every metric is optional.
+type NodeTelemetry struct {
+	CPUTemperature *float64         `json:"cpu_temperature"` // degrees Celsius
+	CPULoad        *float64         `json:"cpu_load"`        // percent, 0–100
+	Radios         []RadioTelemetry `json:"radios"`
+}
+
+// RadioTelemetry describes one of a node's Wi-Fi radios.
+type RadioTelemetry struct {
+	Band    string   `json:"band"` // e.g. "2.4GHz", "5GHz", "6GHz"
+	Channel int      `json:"channel"`
+	TxPower *float64 `json:"tx_power"` // dBm
 }
 
 // IPv6Address holds the IPv6 configuration details for a single node interface.
@@ -257,17 +534,191 @@ type IPv6Address struct {
 	Interface string `json:"interface"`
 }
 
+// IPv6 address scopes as classified by IPv6Address.IsGlobal and
+// IsLinkLocal.
+const (
+	ipv6ScopeGlobal    = "global"
+	ipv6ScopeLinkLocal = "link"
+)
+
+// scopeOf normalizes a's scope. The reported Scope is preferred ("link" and
+// "link-local" are treated alike); when it is empty, the address itself is
+// classified.
+func (a IPv6Address) scopeOf() string {
+	s := strings.ToLower(strings.TrimSpace(a.Scope))
+	switch s {
+	case "link", "link-local", "link_local", "linklocal":
+		return ipv6ScopeLinkLocal
+	}
+	if s != "" {
+		return s
+	}
+	addr, err := netip.ParseAddr(strings.SplitN(a.Address, "/", 2)[0])
+	switch {
+	case err != nil:
+		return ""
+	case addr.IsLinkLocalUnicast():
+		return ipv6ScopeLinkLocal
+	case addr.IsGlobalUnicast() && !addr.IsPrivate():
+		return ipv6ScopeGlobal
+	}
+	return ""
+}
+
+// IsGlobal reports whether a is a globally routable address.
+func (a IPv6Address) IsGlobal() bool { return a.scopeOf() == ipv6ScopeGlobal }
+
+// IsLinkLocal reports whether a is a link-local (fe80::/10) address.
+func (a IPv6Address) IsLinkLocal() bool { return a.scopeOf() == ipv6ScopeLinkLocal }
+
+// firstIPv6 returns the Address of the first entry of addrs matching keep.
+func firstIPv6(addrs []IPv6Address, keep func(IPv6Address) bool) (string, bool) {
+	for _, a := range addrs {
+		if keep(a) {
+			return a.Address, true
+		}
+	}
+	return "", false
+}
+
+// GlobalIPv6 returns the node's first globally routable IPv6 address, as
+// reported (possibly with a "/64" prefix length), or false if it has none.
+func (n *EeroNode) GlobalIPv6() (string, bool) {
+	return firstIPv6(n.IPv6Addresses, IPv6Address.IsGlobal)
+}
+
+// LinkLocalIPv6 returns the node's first link-local IPv6 address, or false
+// if it has none.
+func (n *EeroNode) LinkLocalIPv6() (string, bool) {
+	return firstIPv6(n.IPv6Addresses, IPv6Address.IsLinkLocal)
+}
+
 // PowerInfo details connection details regarding power usage.
 type PowerInfo struct {
 	PowerSource string `json:"power_source"`
 }
 
+// MTU bounds accepted by SetMTU. 576 is the IPv4 minimum every host must
+// accept; 1500 is the standard Ethernet payload eero routers support.
+const (
+	MinMTU = 576
+	MaxMTU = 1500
+)
+
+// wanConfig is the subset of the WAN configuration read and written by
+// GetMTU and SetMTU.
+type wanConfig struct {
+	MTU int `json:"mtu"`
+}
+
+// maxNetworkNameLen is the longest network name eero accepts. The name is
+// broadcast as the Wi-Fi SSID, which 802.11 limits to 32 bytes.
+const maxNetworkNameLen = 32
+
+// MaxDNSServers is the number of custom DNS servers eero accepts per address
+// family: up to two IPv4 and two IPv6 servers.
+const MaxDNSServers = 2
+
+// DNS modes reported in NetworkDNS.Mode and NetworkIPv6.NameServers.Mode.
+const (
+	DNSModeAutomatic = "automatic"
+	DNSModeCustom    = "custom"
+)
+
+// dnsRequest is the body written by SetDNS and ResetDNS. The top-level mode
+// and servers apply to IPv4; IPv6 is configured independently.
+type dnsRequest struct {
+	Mode   string            `json:"mode"`
+	Custom *dnsServers       `json:"custom,omitempty"`
+	IPv6   *dnsFamilyRequest `json:"ipv6"`
+}
+
+// dnsFamilyRequest is the IPv6 part of a dnsRequest.
+type dnsFamilyRequest struct {
+	Mode   string      `json:"mode"`
+	Custom *dnsServers `json:"custom,omitempty"`
+}
+
+// dnsServers lists custom DNS server addresses.
+type dnsServers struct {
+	IPs []string `json:"ips"`
+}
+
+// networkNameRequest is the body for renaming a network.
+type networkNameRequest struct {
+	Name string `json:"name"`
+}
+
+// nodeLocationRequest is the body for renaming a node's location.
+type nodeLocationRequest struct {
+	Location string `json:"location"`
+}
+
+// backhaulRequest is the body for changing a node's backhaul preference.
+type backhaulRequest struct {
+	PreferWired bool `json:"prefer_wired_backhaul"`
+}
+
+// ForwardProtocol is the transport protocol of a port forward.
+type ForwardProtocol string
+
+// Port forward protocols accepted by CreateForward.
+const (
+	ForwardTCP  ForwardProtocol = "tcp"
+	ForwardUDP  ForwardProtocol = "udp"
+	ForwardBoth ForwardProtocol = "both"
+)
+
+// ForwardRule is a port forward from the WAN to a device on the LAN. The
+// target is identified by either IP or MAC; exactly one must be set when
+// creating a rule. URL is assigned by eero and is only populated on rules
+// returned by the API.
+type ForwardRule struct {
+	URL          string          `json:"url,omitempty"`
+	ExternalPort int             `json:"gateway_port"`
+	InternalPort int             `json:"client_port"`
+	Protocol     ForwardProtocol `json:"protocol"`
+	IP           string          `json:"ip,omitempty"`
+	MAC          string          `json:"mac,omitempty"`
+	Description  string          `json:"description"`
+}
+
+// Data usage windows accepted by NetworkService.DataUsage.
+const (
+	UsageWindowDay   = "day"
+	UsageWindowWeek  = "week"
+	UsageWindowMonth = "month"
+)
+
+// DataUsage is the traffic a network moved over a time window, as reported
+// by eero's insights. Byte counts cover the whole window.
+type DataUsage struct {
+	Download int64             `json:"download"`
+	Upload   int64             `json:"upload"`
+	Devices  []DeviceDataUsage `json:"devices"`
+}
+
+// DeviceDataUsage is one device's share of a DataUsage window.
+type DeviceDataUsage struct {
+	URL         string `json:"url"`
+	MAC         string `json:"mac"`
+	DisplayName string `json:"display_name"`
+	Download    int64  `json:"download"`
+	Upload      int64  `json:"upload"`
+}
+
 // --- Methods ---
 
 // Get retrieves full details for the specified network.
 //
 // The networkURL parameter should be the exact relative URL from the account
 // response (e.g., "/2.2/networks/12345"). Do not manually construct the path.
+//
+// The returned details carry the response ETag; to avoid overwriting another
+// admin's concurrent change, pass it to WithIfMatch for subsequent updates.
+//
+// With WithCache, repeated calls within the TTL are served from memory; with
+// WithConditionalRequests, an unchanged network sets NotModified.
 func (s *NetworkService) Get(ctx context.Context, networkURL string) (*NetworkDetails, error) {
 	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL, nil)
 	if err != nil {
@@ -275,13 +726,58 @@ func (s *NetworkService) Get(ctx context.Context, networkURL string) (*NetworkDe
 	}
 
 	var resp EeroResponse[NetworkDetails]
-	if err := s.client.doRaw(req, &resp); err != nil {
+	header, notModified, err := s.client.doCachedHeader(req, &resp)
+	if err != nil {
 		return nil, fmt.Errorf("network: %w", err)
 	}
+	resp.Data.ETag = header.Get("ETag")
+	resp.Data.NotModified = notModified
 
 	return &resp.Data, nil
 }
 
+// GetWithMeta is like Get but also returns the response's full meta object,
+// exposing server-provided fields (e.g. "server_time") that Get discards.
+func (s *NetworkService) GetWithMeta(ctx context.Context, networkURL string) (*NetworkDetails, Meta, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp struct {
+		Meta Meta           `json:"meta"`
+		Data NetworkDetails `json:"data"`
+	}
+	header, err := s.client.doRawHeader(req, &resp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("network: %w", err)
+	}
+	resp.Data.ETag = header.Get("ETag")
+
+	return &resp.Data, resp.Meta, nil
+}
+
+// Health returns only the health indicators of the specified network.
+//
+// The API has no dedicated health endpoint, so this still fetches the
+// network resource, but only the "health" object is decoded; it is the
+// cheaper choice for monitoring loops that ignore the rest of the payload.
+func (s *NetworkService) Health(ctx context.Context, networkURL string) (*Health, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[struct {
+		Health Health `json:"health"`
+	}]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: health: %w", err)
+	}
+
+	return &resp.Data.Health, nil
+}
+
 // Reboot triggers a reboot of all eero devices in the specified network.
 //
 // The networkURL parameter should be the exact relative URL from the account
@@ -298,3 +794,900 @@ func (s *NetworkService) Reboot(ctx context.Context, networkURL string) error {
 
 	return nil
 }
+
+// Default polling schedule for RebootAndWait.
+const (
+	defaultRebootPollInitial = 10 * time.Second
+	defaultRebootPollMax     = 30 * time.Second
+)
+
+// WaitOption customizes a NetworkService.RebootAndWait call.
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	initial, max time.Duration
+}
+
+// PollInterval sets how long RebootAndWait waits before its first readiness
+// check (initial, default 10s) and the cap the delay doubles up to between
+// later checks (max, default 30s). Non-positive values keep the defaults.
+func PollInterval(initial, max time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if initial > 0 {
+			o.initial = initial
+		}
+		if max > 0 {
+			o.max = max
+		}
+	}
+}
+
+// RebootAndWait reboots the network like Reboot, then polls Get until the
+// network reports status "online" with the ISP up (Health.Internet.ISPUp),
+// backing off between checks (see PollInterval). Errors from Get while the
+// mesh is down are expected and do not stop the wait.
+//
+// The wait only ends at readiness or when ctx is done, so pass a context
+// with a deadline. On expiry the error wraps ctx.Err() and reports the last
+// observed status (or the last Get error) to show how far recovery got.
+func (s *NetworkService) RebootAndWait(ctx context.Context, networkURL string, opts ...WaitOption) error {
+	o := waitOptions{initial: defaultRebootPollInitial, max: defaultRebootPollMax}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.max < o.initial {
+		o.max = o.initial
+	}
+
+	if err := s.Reboot(ctx, networkURL); err != nil {
+		return err
+	}
+
+	var (
+		observed   bool
+		lastStatus string
+		lastISPUp  bool
+		lastErr    error
+	)
+	timeout := func(cause error) error {
+		if !observed {
+			return fmt.Errorf("network: reboot and wait: %w (no status observed; last error: %v)", cause, lastErr)
+		}
+		return fmt.Errorf("network: reboot and wait: %w (last status %q, isp_up=%t)", cause, lastStatus, lastISPUp)
+	}
+
+	for delay := o.initial; ; delay = min(2*delay, o.max) {
+		if err := sleepContext(ctx, delay); err != nil {
+			return timeout(err)
+		}
+
+		details, err := s.Get(WithCacheBypass(ctx), networkURL)
+		if err != nil {
+			if ctx.Err() != nil {
+				return timeout(ctx.Err())
+			}
+			lastErr = err
+			continue
+		}
+		observed = true
+		lastStatus, lastISPUp = details.Status, details.Health.Internet.ISPUp
+		if strings.EqualFold(lastStatus, "online") && lastISPUp {
+			return nil
+		}
+	}
+}
+
+// RebootNode reboots a single eero node, leaving the rest of the mesh up.
+// Clients attached to that node will roam to neighbours while it restarts.
+//
+// The eeroURL parameter should be the exact relative URL of the node (the URL
+// field of an EeroNode, e.g. "/2.2/eeros/67890"). The node is fetched first;
+// if its heartbeat is not OK, an error wrapping ErrNodeOffline is returned
+// without sending the reboot command.
+func (s *NetworkService) RebootNode(ctx context.Context, eeroURL string) error {
+	node, err := s.GetNode(ctx, eeroURL)
+	if err != nil {
+		return err
+	}
+	if !node.HeartbeatOK {
+		return fmt.Errorf("network: reboot node %q: %w", node.Location, ErrNodeOffline)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPost, eeroURL+"/reboot", nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: reboot node: %w", err)
+	}
+
+	return nil
+}
+
+// SetBackhaulPreference tells a leaf eero whether to prefer its Ethernet
+// link for backhaul (preferWired true) or to let the mesh choose between
+// wired and wireless automatically (false). EeroNode.Wired and UsingWan
+// reflect the link actually in use.
+//
+// The eeroURL parameter should be the exact relative URL of the node (the URL
+// field of an EeroNode, e.g. "/2.2/eeros/67890"). An error wrapping
+// ErrBackhaulUnsupported is returned for the gateway node, which has no
+// backhaul, and when the API rejects the setting for the node's hardware.
+func (s *NetworkService) SetBackhaulPreference(ctx context.Context, eeroURL string, preferWired bool) error {
+	node, err := s.GetNode(ctx, eeroURL)
+	if err != nil {
+		return err
+	}
+	if node.Gateway {
+		return fmt.Errorf("network: backhaul preference %q: %w", node.Location, ErrBackhaulUnsupported)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, eeroURL, backhaulRequest{PreferWired: preferWired})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && (apiErr.HTTPStatusCode == http.StatusBadRequest || apiErr.HTTPStatusCode == http.StatusUnprocessableEntity) {
+			return fmt.Errorf("network: backhaul preference: %w: %w", ErrBackhaulUnsupported, err)
+		}
+		return fmt.Errorf("network: backhaul preference: %w", err)
+	}
+
+	return nil
+}
+
+// SetNodeLocation renames the room label of a single eero node
+// (EeroNode.Location, e.g. "Living Room"), for example after moving it.
+// An empty or whitespace-only location is rejected with ErrInvalidArgument
+// before any request is sent.
+//
+// The eeroURL parameter should be the exact relative URL of the node (the URL
+// field of an EeroNode, e.g. "/2.2/eeros/67890").
+func (s *NetworkService) SetNodeLocation(ctx context.Context, eeroURL, location string) error {
+	if strings.TrimSpace(location) == "" {
+		return fmt.Errorf("network: set node location: %w: location is empty", ErrInvalidArgument)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, eeroURL, nodeLocationRequest{Location: location})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: set node location: %w", err)
+	}
+
+	return nil
+}
+
+// GetNode fetches a single eero node by its URL, which is much cheaper than
+// re-fetching the whole network to refresh one node's HeartbeatOK or
+// MeshQualityBars.
+//
+// The eeroURL parameter should be the exact relative URL of the node (the URL
+// field of an EeroNode, e.g. "/2.2/eeros/67890"). An unknown node yields an
+// *APIError whose IsNotFound reports true.
+func (s *NetworkService) GetNode(ctx context.Context, eeroURL string) (*EeroNode, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, eeroURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[EeroNode]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: node: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// UpdateStatus returns the firmware update state of the specified network.
+// It is a convenience over Get that returns only the Updates sub-object.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+//
+// UpdateStatus always fetches fresh details, bypassing any WithCache cache.
+func (s *NetworkService) UpdateStatus(ctx context.Context, networkURL string) (*NetworkUpdates, error) {
+	details, err := s.Get(WithCacheBypass(ctx), networkURL)
+	if err != nil {
+		return nil, err
+	}
+	return &details.Updates, nil
+}
+
+// StartUpdate begins installing the pending firmware update on every eero in
+// the network. It first checks UpdateStatus and returns an error wrapping
+// ErrUpdateNotAvailable, without POSTing, when CanUpdateNow is false.
+//
+// eero starts the update asynchronously and typically answers 202 Accepted
+// with no body; poll UpdateStatus to follow progress. Nodes reboot during
+// the update, so expect a connectivity gap.
+func (s *NetworkService) StartUpdate(ctx context.Context, networkURL string) error {
+	status, err := s.UpdateStatus(ctx, networkURL)
+	if err != nil {
+		return err
+	}
+	if !status.CanUpdateNow {
+		return fmt.Errorf("network: start update: %w (has_update=%t)", ErrUpdateNotAvailable, status.HasUpdate)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPost, networkURL+"/updates", nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: start update: %w", err)
+	}
+
+	return nil
+}
+
+// StartSpeedTest asks eero to run a fresh speed test on the specified network
+// and returns a job handle that can be polled with GetSpeedTest.
+//
+// eero may acknowledge the request with 202 Accepted and no body. In that case
+// the returned job points at the network's speed test resource
+// (networkURL + "/speedtest"), which reports the result once it is available.
+func (s *NetworkService) StartSpeedTest(ctx context.Context, networkURL string) (*SpeedTestJob, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPost, networkURL+"/speedtest", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[SpeedTestJob]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: speed test: %w", err)
+	}
+
+	job := resp.Data
+	if job.URL == "" {
+		job.URL = networkURL + "/speedtest"
+	}
+	if job.Status == "" {
+		job.Status = string(SpeedTestRunning)
+	}
+	return &job, nil
+}
+
+// GetSpeedTest polls a speed test job started with StartSpeedTest. Use
+// NetworkSpeed.TestStatus on the result to tell a running test from a
+// completed or failed one; Up and Down are only meaningful once complete.
+//
+// The jobURL parameter should be the URL from the SpeedTestJob. A poll that
+// may outlast the client's fallback timeout can be given its own with
+// WithRequestTimeout.
+func (s *NetworkService) GetSpeedTest(ctx context.Context, jobURL string) (*NetworkSpeed, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, jobURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[NetworkSpeed]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: speed test: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// OwnerInfo resolves the owner of the specified network to a name and contact
+// details, which NetworkDetails.Owner does not provide on its own. This is
+// mainly useful for displaying who manages a shared network.
+//
+// If the authenticated account is not allowed to see the owner (the API
+// answers 403), the returned error wraps both ErrOwnerHidden and the
+// underlying *APIError.
+func (s *NetworkService) OwnerInfo(ctx context.Context, networkURL string) (*OwnerInfo, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL+"/owner", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[OwnerInfo]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && (apiErr.HTTPStatusCode == http.StatusForbidden || apiErr.Code == http.StatusForbidden) {
+			return nil, fmt.Errorf("network: owner: %w: %w", ErrOwnerHidden, err)
+		}
+		return nil, fmt.Errorf("network: owner: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// GetMTU returns the WAN MTU configured for the network. A zero value means
+// no override is set and the router uses its default (normally 1500).
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/wan" suffix is appended
+// automatically.
+func (s *NetworkService) GetMTU(ctx context.Context, networkURL string) (int, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL+"/wan", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var resp EeroResponse[wanConfig]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return 0, fmt.Errorf("network: get mtu: %w", err)
+	}
+
+	return resp.Data.MTU, nil
+}
+
+// SetMTU overrides the WAN MTU, which PPPoE and some double-NAT setups need
+// (PPPoE typically requires 1492). Values outside MinMTU–MaxMTU are rejected
+// with ErrInvalidArgument before any request is sent.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+func (s *NetworkService) SetMTU(ctx context.Context, networkURL string, mtu int) error {
+	if mtu < MinMTU || mtu > MaxMTU {
+		return fmt.Errorf("network: set mtu: %w: mtu %d is outside %d-%d", ErrInvalidArgument, mtu, MinMTU, MaxMTU)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL+"/wan", wanConfig{MTU: mtu})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: set mtu: %w", err)
+	}
+
+	return nil
+}
+
+// ListForwards returns the port forwarding rules on the specified network.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/forwards" suffix is appended
+// automatically.
+func (s *NetworkService) ListForwards(ctx context.Context, networkURL string) ([]ForwardRule, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL+"/forwards", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[[]ForwardRule]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: list forwards: %w", err)
+	}
+
+	return resp.Data, nil
+}
+
+// CreateForward adds a port forwarding rule and returns it as created by
+// eero, including the URL to pass to DeleteForward.
+//
+// The rule is validated before sending: ports must be within 1–65535, the
+// protocol must be tcp, udp or both, and exactly one of IP or MAC must name
+// the target. For tcp and udp a missing port is mirrored from the other one;
+// ForwardBoth requires both ports to be set explicitly. An IP target must be
+// an IPv4 host address inside the network's LAN subnet, which is checked
+// against the network's DHCP lease. Violations return ErrInvalidArgument.
+// Networks in bridge mode are refused with an error wrapping ErrBridgeMode.
+func (s *NetworkService) CreateForward(ctx context.Context, networkURL string, rule ForwardRule) (*ForwardRule, error) {
+	body, err := normalizeForwardRule(rule)
+	if err != nil {
+		return nil, fmt.Errorf("network: create forward: %w", err)
+	}
+
+	// The mode and subnet checks must not run against cached details.
+	details, err := s.Get(WithCacheBypass(ctx), networkURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := details.RequireRouterMode(); err != nil {
+		return nil, fmt.Errorf("network: create forward: %w", err)
+	}
+	if body.IP != "" {
+		if err := checkInLAN(net.ParseIP(body.IP).To4(), details.Lease.DHCP); err != nil {
+			return nil, fmt.Errorf("network: create forward: %w", err)
+		}
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPost, networkURL+"/forwards", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[ForwardRule]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: create forward: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// DeleteForward removes the port forwarding rule at forwardURL (the URL
+// field of a ForwardRule).
+func (s *NetworkService) DeleteForward(ctx context.Context, forwardURL string) error {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodDelete, forwardURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: delete forward: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeForwardRule validates rule and returns the body to send, with the
+// URL cleared, a missing tcp/udp port mirrored and the target canonicalized.
+func normalizeForwardRule(rule ForwardRule) (ForwardRule, error) {
+	rule.URL = ""
+
+	switch rule.Protocol {
+	case ForwardTCP, ForwardUDP:
+		if rule.ExternalPort == 0 {
+			rule.ExternalPort = rule.InternalPort
+		}
+		if rule.InternalPort == 0 {
+			rule.InternalPort = rule.ExternalPort
+		}
+	case ForwardBoth:
+		if (rule.ExternalPort == 0) != (rule.InternalPort == 0) {
+			return rule, fmt.Errorf("%w: protocol %q requires both external and internal ports", ErrInvalidArgument, rule.Protocol)
+		}
+	default:
+		return rule, fmt.Errorf("%w: unknown protocol %q", ErrInvalidArgument, rule.Protocol)
+	}
+
+	for _, port := range []int{rule.ExternalPort, rule.InternalPort} {
+		if port < 1 || port > 65535 {
+			return rule, fmt.Errorf("%w: port %d is outside 1-65535", ErrInvalidArgument, port)
+		}
+	}
+
+	switch {
+	case rule.IP != "" && rule.MAC != "":
+		return rule, fmt.Errorf("%w: set only one of IP or MAC", ErrInvalidArgument)
+	case rule.IP != "":
+		addr := net.ParseIP(rule.IP).To4()
+		if addr == nil {
+			return rule, fmt.Errorf("%w: %q is not an IPv4 address", ErrInvalidArgument, rule.IP)
+		}
+		rule.IP = addr.String()
+	case rule.MAC != "":
+		if _, err := net.ParseMAC(rule.MAC); err != nil {
+			return rule, fmt.Errorf("%w: invalid MAC %q", ErrInvalidArgument, rule.MAC)
+		}
+	default:
+		return rule, fmt.Errorf("%w: a target IP or MAC is required", ErrInvalidArgument)
+	}
+
+	return rule, nil
+}
+
+// SetName renames the network. On eero the network name and the main Wi-Fi
+// SSID are the same setting: the PUT changes NetworkDetails.Name, and the
+// nodes start broadcasting the new SSID shortly afterwards, disconnecting
+// clients until they rejoin. There is therefore no separate SetSSID.
+// DisplayName is derived by eero and is not written.
+//
+// Empty or whitespace-only names, and names longer than 32 bytes (the SSID
+// limit), are rejected with ErrInvalidArgument before any request is sent.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+func (s *NetworkService) SetName(ctx context.Context, networkURL, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("network: set name: %w: name is empty", ErrInvalidArgument)
+	}
+	if len(name) > maxNetworkNameLen {
+		return fmt.Errorf("network: set name: %w: name exceeds %d bytes", ErrInvalidArgument, maxNetworkNameLen)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL, networkNameRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: set name: %w", err)
+	}
+
+	return nil
+}
+
+// SetUPnP enables or disables UPnP port mapping on the network
+// (NetworkDetails.UpnpEnabled).
+func (s *NetworkService) SetUPnP(ctx context.Context, networkURL string, enabled bool) error {
+	_, err := s.setFlag(ctx, networkURL, "set upnp", "upnp", enabled)
+	return err
+}
+
+// SetSQM enables or disables Smart Queue Management, eero's bufferbloat
+// mitigation (NetworkDetails.SQMEnabled).
+func (s *NetworkService) SetSQM(ctx context.Context, networkURL string, enabled bool) error {
+	_, err := s.setFlag(ctx, networkURL, "set sqm", "sqm", enabled)
+	return err
+}
+
+// SetBandSteering enables or disables band steering, which merges the 2.4 GHz
+// and 5 GHz radios under one SSID (NetworkDetails.BandSteering).
+func (s *NetworkService) SetBandSteering(ctx context.Context, networkURL string, enabled bool) error {
+	_, err := s.setFlag(ctx, networkURL, "set band steering", "band_steering", enabled)
+	return err
+}
+
+// SetIPv6Upstream enables or disables IPv6 on the WAN side of the network
+// (NetworkDetails.IPv6Upstream).
+func (s *NetworkService) SetIPv6Upstream(ctx context.Context, networkURL string, enabled bool) error {
+	_, err := s.setFlag(ctx, networkURL, "set ipv6 upstream", "ipv6_upstream", enabled)
+	return err
+}
+
+// SetWPA3 enables or disables WPA3 on the network (NetworkDetails.Wpa3).
+// Older clients that only speak WPA2 may fail to rejoin once WPA3 is on.
+//
+// Switching WPA3 can require the nodes to restart their radios or reboot.
+// When the API says so, the message from the response's meta "warning" field
+// is returned as warning; it is empty otherwise.
+func (s *NetworkService) SetWPA3(ctx context.Context, networkURL string, enabled bool) (warning string, err error) {
+	meta, err := s.setFlag(ctx, networkURL, "set wpa3", "wpa3", enabled)
+	if err != nil {
+		return "", err
+	}
+	if raw, ok := meta["warning"]; ok {
+		_ = json.Unmarshal(raw, &warning)
+	}
+	return warning, nil
+}
+
+// setFlag PUTs a single boolean network setting, {field: enabled}, to
+// networkURL and returns the response meta. op names the operation in errors.
+func (s *NetworkService) setFlag(ctx context.Context, networkURL, op, field string, enabled bool) (Meta, error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL, map[string]bool{field: enabled})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Meta Meta `json:"meta"`
+	}
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: %s: %w", op, err)
+	}
+
+	return resp.Meta, nil
+}
+
+// SetAdBlock enables or disables eero Secure ad blocking on the network
+// (PremiumDNS.DNSPolicies.AdBlock).
+//
+// An error wrapping ErrPremiumRequired is returned when the network lacks an
+// active eero Secure or eero Plus subscription (see
+// NetworkDetails.PremiumStatus).
+func (s *NetworkService) SetAdBlock(ctx context.Context, networkURL string, enabled bool) error {
+	return s.setDNSPolicy(ctx, networkURL, "set ad block", "ad_block", enabled)
+}
+
+// SetMalwareBlock enables or disables eero Secure malware and phishing
+// blocking on the network (PremiumDNS.DNSPolicies.BlockMalware).
+//
+// An error wrapping ErrPremiumRequired is returned when the network lacks an
+// active eero Secure or eero Plus subscription.
+func (s *NetworkService) SetMalwareBlock(ctx context.Context, networkURL string, enabled bool) error {
+	return s.setDNSPolicy(ctx, networkURL, "set malware block", "block_malware", enabled)
+}
+
+// setDNSPolicy PUTs a single boolean DNS policy, {field: enabled}, to the
+// network's DNS policies endpoint. An answer that APIError.IsPremiumRequired
+// recognizes is wrapped with ErrPremiumRequired.
+func (s *NetworkService) setDNSPolicy(ctx context.Context, networkURL, op, field string, enabled bool) error {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL+"/dns_policies/network", map[string]bool{field: enabled})
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.IsPremiumRequired() {
+			return fmt.Errorf("network: %s: %w: %w", op, ErrPremiumRequired, err)
+		}
+		return fmt.Errorf("network: %s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetDNS switches the network to custom DNS servers. Each entry must be an
+// IPv4 or IPv6 address; IPv4 servers are written to the network's DNS
+// settings (NetworkDetails.DNS) and IPv6 servers to its IPv6 name server
+// settings (NetworkIPv6.NameServers). An address family with no servers in
+// the list stays on (or returns to) automatic DNS.
+//
+// An empty list, an unparsable address, or more than MaxDNSServers servers
+// of one family return ErrInvalidArgument before any request is sent. Use
+// ResetDNS to go back to automatic DNS for both families.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/dns" suffix is appended
+// automatically.
+func (s *NetworkService) SetDNS(ctx context.Context, networkURL string, servers []string) error {
+	if len(servers) == 0 {
+		return fmt.Errorf("network: set dns: %w: no servers given (use ResetDNS for automatic DNS)", ErrInvalidArgument)
+	}
+
+	var v4, v6 []string
+	for _, server := range servers {
+		ip := net.ParseIP(server)
+		switch {
+		case ip == nil:
+			return fmt.Errorf("network: set dns: %w: %q is not an IP address", ErrInvalidArgument, server)
+		case ip.To4() != nil:
+			v4 = append(v4, ip.String())
+		default:
+			v6 = append(v6, ip.String())
+		}
+	}
+	if len(v4) > MaxDNSServers || len(v6) > MaxDNSServers {
+		return fmt.Errorf("network: set dns: %w: at most %d IPv4 and %d IPv6 servers are allowed", ErrInvalidArgument, MaxDNSServers, MaxDNSServers)
+	}
+
+	body := dnsRequest{Mode: DNSModeAutomatic, IPv6: &dnsFamilyRequest{Mode: DNSModeAutomatic}}
+	if len(v4) > 0 {
+		body.Mode = DNSModeCustom
+		body.Custom = &dnsServers{IPs: v4}
+	}
+	if len(v6) > 0 {
+		body.IPv6.Mode = DNSModeCustom
+		body.IPv6.Custom = &dnsServers{IPs: v6}
+	}
+
+	return s.putDNS(ctx, networkURL, "set dns", body)
+}
+
+// ResetDNS reverts both IPv4 and IPv6 DNS on the network to automatic (the
+// servers handed out by the ISP, or eero's own when eero Secure filtering is
+// active).
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+func (s *NetworkService) ResetDNS(ctx context.Context, networkURL string) error {
+	body := dnsRequest{Mode: DNSModeAutomatic, IPv6: &dnsFamilyRequest{Mode: DNSModeAutomatic}}
+	return s.putDNS(ctx, networkURL, "reset dns", body)
+}
+
+// putDNS writes body to the network's DNS settings endpoint.
+func (s *NetworkService) putDNS(ctx context.Context, networkURL, op string, body dnsRequest) error {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL+"/dns", body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("network: %s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PauseAll pauses internet access for the whole network.
+//
+// The network-wide pause endpoint ({networkURL}/pause) is tried first. When
+// the API does not offer it (404, 405 or 501), PauseAll falls back to pausing
+// every profile that is not already paused, at most five at a time, and
+// records which profiles it paused so that ResumeAll restores exactly the
+// prior state. Devices that belong to no profile are not affected by the
+// fallback.
+//
+// The fallback cannot spare the account owner's own profile: eero profiles
+// are device groups rather than user accounts, and the API does not mark
+// one as the owner's. A profile holding the owner's devices is therefore
+// paused too. To keep some devices online, leave them out of every profile
+// or pause profiles individually with ProfileService.Pause.
+//
+// If some profiles fail to pause, the ones that succeeded are still recorded
+// and the failures are returned joined together.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+func (s *NetworkService) PauseAll(ctx context.Context, networkURL string) error {
+	native, err := s.setNetworkPaused(ctx, networkURL, true)
+	if native || err != nil {
+		return err
+	}
+
+	profiles, err := s.client.Profile.List(ctx, networkURL)
+	if err != nil {
+		return fmt.Errorf("network: pause all: %w", err)
+	}
+	var targets []string
+	for _, p := range profiles {
+		if !p.Paused {
+			targets = append(targets, p.URL)
+		}
+	}
+
+	paused, err := s.setProfilesPaused(ctx, targets, true)
+	s.client.recordPausedProfiles(networkURL, paused)
+	if err != nil {
+		return fmt.Errorf("network: pause all: %w", err)
+	}
+	return nil
+}
+
+// ResumeAll undoes PauseAll. The network-wide endpoint is tried first; when
+// it is unavailable, only the profiles recorded by this client's PauseAll
+// fallback are unpaused, leaving profiles that were already paused before
+// PauseAll untouched. Without such a record, the fallback does nothing.
+//
+// Profiles that fail to unpause stay recorded so that ResumeAll can be
+// retried.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345").
+func (s *NetworkService) ResumeAll(ctx context.Context, networkURL string) error {
+	native, err := s.setNetworkPaused(ctx, networkURL, false)
+	if err != nil {
+		return err
+	}
+	targets := s.client.takePausedProfiles(networkURL)
+	if native {
+		return nil
+	}
+
+	resumed, err := s.setProfilesPaused(ctx, targets, false)
+	if err != nil {
+		done := make(map[string]bool, len(resumed))
+		for _, u := range resumed {
+			done[u] = true
+		}
+		var remaining []string
+		for _, u := range targets {
+			if !done[u] {
+				remaining = append(remaining, u)
+			}
+		}
+		s.client.recordPausedProfiles(networkURL, remaining)
+		return fmt.Errorf("network: resume all: %w", err)
+	}
+	return nil
+}
+
+// setNetworkPaused calls the network-wide pause endpoint. It reports
+// native=false, with a nil error, when the API does not support it.
+func (s *NetworkService) setNetworkPaused(ctx context.Context, networkURL string, paused bool) (native bool, err error) {
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodPut, networkURL+"/pause", pauseRequest{Paused: paused})
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			switch apiErr.HTTPStatusCode {
+			case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+				return false, nil
+			}
+		}
+		if paused {
+			return false, fmt.Errorf("network: pause all: %w", err)
+		}
+		return false, fmt.Errorf("network: resume all: %w", err)
+	}
+
+	return true, nil
+}
+
+// maxPauseConcurrency bounds the number of profile updates the PauseAll and
+// ResumeAll fallbacks have in flight at once.
+const maxPauseConcurrency = 5
+
+// setProfilesPaused pauses or unpauses the given profiles, at most
+// maxPauseConcurrency at a time, and returns the URLs that succeeded, along
+// with any failures joined together.
+func (s *NetworkService) setProfilesPaused(ctx context.Context, profileURLs []string, paused bool) ([]string, error) {
+	errs := make([]error, len(profileURLs))
+	sem := make(chan struct{}, maxPauseConcurrency)
+	var wg sync.WaitGroup
+	for i, u := range profileURLs {
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			if paused {
+				errs[i] = s.client.Profile.Pause(ctx, u)
+			} else {
+				errs[i] = s.client.Profile.Unpause(ctx, u)
+			}
+		}(i, u)
+	}
+	wg.Wait()
+
+	var done []string
+	for i, u := range profileURLs {
+		if errs[i] == nil {
+			done = append(done, u)
+		}
+	}
+	return done, errors.Join(errs...)
+}
+
+// recordPausedProfiles adds profileURLs to the set PauseAll paused on
+// networkURL.
+func (c *Client) recordPausedProfiles(networkURL string, profileURLs []string) {
+	if len(profileURLs) == 0 {
+		return
+	}
+	c.pausedProfilesMu.Lock()
+	defer c.pausedProfilesMu.Unlock()
+	if c.pausedProfiles == nil {
+		c.pausedProfiles = make(map[string][]string)
+	}
+	c.pausedProfiles[networkURL] = append(c.pausedProfiles[networkURL], profileURLs...)
+}
+
+// takePausedProfiles removes and returns the profiles PauseAll paused on
+// networkURL.
+func (c *Client) takePausedProfiles(networkURL string) []string {
+	c.pausedProfilesMu.Lock()
+	defer c.pausedProfilesMu.Unlock()
+	urls := c.pausedProfiles[networkURL]
+	delete(c.pausedProfiles, networkURL)
+	return urls
+}
+
+// DataUsage retrieves the network's data usage over the trailing window,
+// one of UsageWindowDay, UsageWindowWeek or UsageWindowMonth, with a
+// per-device breakdown. Any other window returns ErrInvalidArgument before
+// any request is sent.
+//
+// Historical insights require an eero Plus subscription; when the API
+// refuses the request for that reason, the returned *APIError matches
+// ErrPremiumRequired under errors.Is (see APIError.IsPremiumRequired). Other
+// refusals, such as a plain 403, do not.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/data_usage/breakdown" suffix
+// is appended automatically.
+func (s *NetworkService) DataUsage(ctx context.Context, networkURL string, window string) (*DataUsage, error) {
+	var (
+		span    time.Duration
+		cadence string
+	)
+	switch window {
+	case UsageWindowDay:
+		span, cadence = 24*time.Hour, "hourly"
+	case UsageWindowWeek:
+		span, cadence = 7*24*time.Hour, "daily"
+	case UsageWindowMonth:
+		span, cadence = 30*24*time.Hour, "daily"
+	default:
+		return nil, fmt.Errorf("network: data usage: %w: unknown window %q", ErrInvalidArgument, window)
+	}
+
+	end := time.Now().UTC().Truncate(time.Second)
+	q := url.Values{}
+	q.Set("start", end.Add(-span).Format(time.RFC3339))
+	q.Set("end", end.Format(time.RFC3339))
+	q.Set("cadence", cadence)
+
+	req, err := s.client.newRequestFromURL(ctx, "network", http.MethodGet, networkURL+"/data_usage/breakdown?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[DataUsage]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("network: data usage: %w", err)
+	}
+
+	return &resp.Data, nil
+}
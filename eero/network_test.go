@@ -2,9 +2,16 @@ package eero_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -277,3 +284,2177 @@ func TestNetworkService_Reboot(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkService_RebootAndWait(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		states      []string // per-poll responses; the last one repeats
+		timeout     time.Duration
+		wantErr     bool
+		wantErrText string
+		wantPolls   int32
+	}{
+		{
+			name: "Success_AfterRecovery",
+			states: []string{
+				"",
+				`{"status": "offline", "health": {"internet": {"isp_up": false}}}`,
+				`{"status": "online", "health": {"internet": {"isp_up": false}}}`,
+				`{"status": "online", "health": {"internet": {"isp_up": true}}}`,
+			},
+			timeout:   2 * time.Second,
+			wantPolls: 4,
+		},
+		{
+			name: "Timeout_ReportsLastStatus",
+			states: []string{
+				`{"status": "offline", "health": {"internet": {"isp_up": false}}}`,
+			},
+			timeout:     100 * time.Millisecond,
+			wantErr:     true,
+			wantErrText: `last status "offline"`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var rebooted atomic.Bool
+			var polls atomic.Int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/reboot", func(w http.ResponseWriter, r *http.Request) {
+				rebooted.Store(true)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+			mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+				if !rebooted.Load() {
+					t.Error("polled before reboot was requested")
+				}
+				n := int(polls.Add(1)) - 1
+				if n >= len(tc.states) {
+					n = len(tc.states) - 1
+				}
+				if tc.states[n] == "" {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					_, _ = w.Write([]byte(`{"meta": {"code": 503, "error": "rebooting"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": ` + tc.states[n] + `}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := eero.NewClient()
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			err = client.Network.RebootAndWait(ctx, "/2.2/networks/44444", eero.PollInterval(time.Millisecond, 5*time.Millisecond))
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got nil")
+				}
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+				}
+				if !strings.Contains(err.Error(), tc.wantErrText) {
+					t.Errorf("Expected error to contain %q, got: %v", tc.wantErrText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if got := polls.Load(); got != tc.wantPolls {
+				t.Errorf("Expected %d polls, got %d", tc.wantPolls, got)
+			}
+		})
+	}
+}
+
+func TestNetworkService_StartSpeedTest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockStatus   int
+		mockResponse string
+		wantErr      bool
+		expectJobURL string
+	}{
+		{
+			name:         "Success_AcceptedWithoutBody",
+			mockStatus:   http.StatusAccepted,
+			mockResponse: ``,
+			expectJobURL: "/2.2/networks/44444/speedtest",
+		},
+		{
+			name:         "Success_JobInBody",
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {"url": "/2.2/networks/44444/speedtest/7", "status": "running"}}`,
+			expectJobURL: "/2.2/networks/44444/speedtest/7",
+		},
+		{
+			name:         "Failure_NotFound",
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "Network not found"}, "data": {}}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/speedtest", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			job, err := client.Network.StartSpeedTest(ctx, "/2.2/networks/44444")
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if job.URL != tc.expectJobURL {
+				t.Errorf("Expected job URL %q, got %q", tc.expectJobURL, job.URL)
+			}
+		})
+	}
+}
+
+func TestNetworkService_GetSpeedTest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockResponse string
+		expectStatus eero.SpeedTestStatus
+		expectDown   float64
+	}{
+		{
+			name:         "Running",
+			mockResponse: `{"meta": {"code": 200}, "data": {"status": "in_progress"}}`,
+			expectStatus: eero.SpeedTestRunning,
+		},
+		{
+			name: "Complete",
+			mockResponse: `{"meta": {"code": 200}, "data": {
+				"status": "completed",
+				"date": "2023-10-01T12:00:00Z",
+				"down": {"value": 850.5, "units": "Mbps"},
+				"up": {"value": 40.1, "units": "Mbps"}
+			}}`,
+			expectStatus: eero.SpeedTestComplete,
+			expectDown:   850.5,
+		},
+		{
+			name:         "Failed",
+			mockResponse: `{"meta": {"code": 200}, "data": {"status": "failed"}}`,
+			expectStatus: eero.SpeedTestFailed,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/speedtest", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET, got %s", r.Method)
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			speed, err := client.Network.GetSpeedTest(ctx, "/2.2/networks/44444/speedtest")
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if got := speed.TestStatus(); got != tc.expectStatus {
+				t.Errorf("Expected status %q, got %q", tc.expectStatus, got)
+			}
+			if speed.Down.Value != tc.expectDown {
+				t.Errorf("Expected down speed %v, got %v", tc.expectDown, speed.Down.Value)
+			}
+		})
+	}
+}
+
+func TestNetworkService_OwnerInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mockStatus   int
+		mockResponse string
+		wantHidden   bool
+		expectEmail  string
+	}{
+		{
+			name:       "Success_OwnerPayload",
+			mockStatus: http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {
+				"url": "/2.2/users/321",
+				"name": "Jane Owner",
+				"email": "jane@example.com",
+				"phone": null
+			}}`,
+			expectEmail: "jane@example.com",
+		},
+		{
+			name:         "Failure_PermissionDenied",
+			mockStatus:   http.StatusForbidden,
+			mockResponse: `{"meta": {"code": 403, "error": "error.network.forbidden"}, "data": {}}`,
+			wantHidden:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/owner", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET, got %s", r.Method)
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			owner, err := client.Network.OwnerInfo(ctx, "/2.2/networks/44444")
+
+			if tc.wantHidden {
+				if !errors.Is(err, eero.ErrOwnerHidden) {
+					t.Fatalf("Expected ErrOwnerHidden, got %v", err)
+				}
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != http.StatusForbidden {
+					t.Errorf("Expected wrapped *eero.APIError with HTTP 403, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if owner.Email == nil || *owner.Email != tc.expectEmail {
+				t.Errorf("Expected owner email %q, got %v", tc.expectEmail, owner.Email)
+			}
+			if owner.Phone != nil {
+				t.Errorf("Expected nil phone for null field, got %q", *owner.Phone)
+			}
+		})
+	}
+}
+
+func TestNetworkService_GetMTU(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444/wan", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"mtu": 1492, "type": "pppoe"}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	mtu, err := client.Network.GetMTU(ctx, "/2.2/networks/44444")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if mtu != 1492 {
+		t.Errorf("Expected MTU 1492, got %d", mtu)
+	}
+}
+
+func TestNetworkService_SetMTU(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		mtu         int
+		expectBody  string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:       "Success_PPPoE",
+			mtu:        1492,
+			expectBody: `{"mtu":1492}`,
+		},
+		{
+			name:        "Failure_TooSmall",
+			mtu:         500,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_TooLarge",
+			mtu:         9000,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/wan", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.SetMTU(ctx, "/2.2/networks/44444", tc.mtu)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SetMTU() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&hits); n != 0 {
+					t.Errorf("Expected no request to be sent, got %d", n)
+				}
+			}
+		})
+	}
+}
+
+func TestNetworkService_StartUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		canUpdateNow bool
+		postStatus   int
+		postBody     string
+		wantErr      bool
+		wantSentinel bool
+		expectPosts  int32
+	}{
+		{
+			name:         "Success_Accepted",
+			canUpdateNow: true,
+			postStatus:   http.StatusAccepted,
+			postBody:     "",
+			expectPosts:  1,
+		},
+		{
+			name:         "Success_OK",
+			canUpdateNow: true,
+			postStatus:   http.StatusOK,
+			postBody:     `{"meta": {"code": 200}, "data": {}}`,
+			expectPosts:  1,
+		},
+		{
+			name:         "Failure_CannotUpdateNow",
+			canUpdateNow: false,
+			wantErr:      true,
+			wantSentinel: true,
+			expectPosts:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			networkURL := "/2.2/networks/44444"
+			var posts int32
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(networkURL, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"updates": {"has_update": true, "can_update_now": %t, "target_firmware": "v7.1.0"}}}`, tc.canUpdateNow)
+			})
+			mux.HandleFunc(networkURL+"/updates", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&posts, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				w.WriteHeader(tc.postStatus)
+				_, _ = w.Write([]byte(tc.postBody))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.StartUpdate(ctx, networkURL)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("StartUpdate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantSentinel && !errors.Is(err, eero.ErrUpdateNotAvailable) {
+				t.Errorf("Expected ErrUpdateNotAvailable, got %v", err)
+			}
+			if n := atomic.LoadInt32(&posts); n != tc.expectPosts {
+				t.Errorf("Expected %d POSTs, got %d", tc.expectPosts, n)
+			}
+		})
+	}
+}
+
+func TestNetworkService_UpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home", "updates": {"has_update": true, "can_update_now": false, "target_firmware": "v7.1.0"}}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	updates, err := client.Network.UpdateStatus(context.Background(), "/2.2/networks/44444")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !updates.HasUpdate || updates.CanUpdateNow || updates.TargetFirmware != "v7.1.0" {
+		t.Errorf("Unexpected updates: %+v", updates)
+	}
+}
+
+func TestNetworkService_GetNode(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/eeros/67890", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": {"url": "/2.2/eeros/67890", "location": "Office", "heartbeat_ok": true, "mesh_quality_bars": 3}
+		}`))
+	})
+	mux.HandleFunc("/2.2/eeros/404", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"meta": {"code": 404, "error": "eero not found"}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	node, err := client.Network.GetNode(ctx, "/2.2/eeros/67890")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if node.Location != "Office" || !node.HeartbeatOK || node.MeshQualityBars != 3 {
+		t.Errorf("Unexpected node: %+v", node)
+	}
+
+	_, err = client.Network.GetNode(ctx, "/2.2/eeros/404")
+	var apiErr *eero.APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+		t.Errorf("Expected a not-found *APIError, got %v", err)
+	}
+}
+
+func TestNetworkService_RebootNode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		heartbeatOK  bool
+		rebootStatus int
+		wantErr      bool
+		wantOffline  bool
+		expectPosts  int32
+	}{
+		{
+			name:         "Success_OnlineNode",
+			heartbeatOK:  true,
+			rebootStatus: http.StatusOK,
+			expectPosts:  1,
+		},
+		{
+			name:        "Failure_OfflineNode",
+			heartbeatOK: false,
+			wantErr:     true,
+			wantOffline: true,
+			expectPosts: 0,
+		},
+		{
+			name:         "Failure_RebootRejected",
+			heartbeatOK:  true,
+			rebootStatus: http.StatusInternalServerError,
+			wantErr:      true,
+			expectPosts:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			eeroURL := "/2.2/eeros/67890"
+			var posts int32
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(eeroURL, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"url": %q, "location": "Basement", "heartbeat_ok": %t}}`, eeroURL, tc.heartbeatOK)
+			})
+			mux.HandleFunc(eeroURL+"/reboot", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&posts, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				w.WriteHeader(tc.rebootStatus)
+				_, _ = fmt.Fprintf(w, `{"meta": {"code": %d}, "data": {}}`, tc.rebootStatus)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.RebootNode(ctx, eeroURL)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RebootNode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantOffline && !errors.Is(err, eero.ErrNodeOffline) {
+				t.Errorf("Expected ErrNodeOffline, got %v", err)
+			}
+			if n := atomic.LoadInt32(&posts); n != tc.expectPosts {
+				t.Errorf("Expected %d reboot POSTs, got %d", tc.expectPosts, n)
+			}
+		})
+	}
+}
+
+func TestNetworkService_SetBackhaulPreference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		gateway         bool
+		preferWired     bool
+		putStatus       int
+		expectBody      string
+		wantErr         bool
+		wantUnsupported bool
+		expectPuts      int32
+	}{
+		{
+			name:        "Success_PreferWired",
+			preferWired: true,
+			putStatus:   http.StatusOK,
+			expectBody:  `{"prefer_wired_backhaul":true}`,
+			expectPuts:  1,
+		},
+		{
+			name:        "Success_Auto",
+			preferWired: false,
+			putStatus:   http.StatusOK,
+			expectBody:  `{"prefer_wired_backhaul":false}`,
+			expectPuts:  1,
+		},
+		{
+			name:            "Failure_GatewayUnsupported",
+			gateway:         true,
+			preferWired:     true,
+			wantErr:         true,
+			wantUnsupported: true,
+			expectPuts:      0,
+		},
+		{
+			name:            "Failure_RejectedByAPI",
+			preferWired:     true,
+			putStatus:       http.StatusBadRequest,
+			expectBody:      `{"prefer_wired_backhaul":true}`,
+			wantErr:         true,
+			wantUnsupported: true,
+			expectPuts:      1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			eeroURL := "/2.2/eeros/67890"
+			var puts int32
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(eeroURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"url": %q, "location": "Office", "gateway": %t, "heartbeat_ok": true}}`, eeroURL, tc.gateway)
+					return
+				}
+				atomic.AddInt32(&puts, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(tc.putStatus)
+				_, _ = fmt.Fprintf(w, `{"meta": {"code": %d}, "data": {}}`, tc.putStatus)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.SetBackhaulPreference(ctx, eeroURL, tc.preferWired)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SetBackhaulPreference() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantUnsupported && !errors.Is(err, eero.ErrBackhaulUnsupported) {
+				t.Errorf("Expected ErrBackhaulUnsupported, got %v", err)
+			}
+			if n := atomic.LoadInt32(&puts); n != tc.expectPuts {
+				t.Errorf("Expected %d PUTs, got %d", tc.expectPuts, n)
+			}
+		})
+	}
+}
+
+func TestNetworkService_GetWithMeta(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200, "server_time": "2024-03-01T08:00:00+0000", "next": "/2.2/networks/44444?cursor=abc"},
+			"data": {"name": "Home", "status": "online"}
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	details, meta, err := client.Network.GetWithMeta(ctx, "/2.2/networks/44444")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if details.Name != "Home" || details.Status != "online" {
+		t.Errorf("Unexpected details: %+v", details)
+	}
+
+	var serverTime eero.EeroTime
+	if err := json.Unmarshal(meta["server_time"], &serverTime); err != nil {
+		t.Fatalf("Failed to decode server_time: %v", err)
+	}
+	if want := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC); !serverTime.Equal(want) {
+		t.Errorf("Expected server_time %v, got %v", want, serverTime.Time)
+	}
+	if got := string(meta["next"]); got != `"/2.2/networks/44444?cursor=abc"` {
+		t.Errorf("Expected next cursor, got %s", got)
+	}
+	if got := string(meta["code"]); got != "200" {
+		t.Errorf("Expected code 200, got %s", got)
+	}
+}
+
+func TestNetworkService_Health(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": {
+				"name": "Home",
+				"health": {
+					"internet": {"status": "green", "isp_up": true},
+					"eero_network": {"status": "green"}
+				}
+			}
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	health, err := client.Network.Health(ctx, "/2.2/networks/44444")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !health.Internet.ISPUp || health.EeroNetwork.Status != "green" {
+		t.Errorf("Unexpected health: %+v", health)
+	}
+	if !health.IsHealthy() {
+		t.Error("Expected IsHealthy() to be true")
+	}
+}
+
+func TestHealth_IsHealthy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		health eero.Health
+		want   bool
+	}{
+		{
+			name: "AllGreen",
+			health: eero.Health{
+				Internet:    eero.InternetHealth{Status: "green", ISPUp: true},
+				EeroNetwork: eero.HealthDetail{Status: "green"},
+			},
+			want: true,
+		},
+		{
+			name: "GoodMixedCase",
+			health: eero.Health{
+				Internet:    eero.InternetHealth{Status: "Good", ISPUp: true},
+				EeroNetwork: eero.HealthDetail{Status: "GREEN"},
+			},
+			want: true,
+		},
+		{
+			name: "ISPDown",
+			health: eero.Health{
+				Internet:    eero.InternetHealth{Status: "green", ISPUp: false},
+				EeroNetwork: eero.HealthDetail{Status: "green"},
+			},
+		},
+		{
+			name: "MeshDegraded",
+			health: eero.Health{
+				Internet:    eero.InternetHealth{Status: "green", ISPUp: true},
+				EeroNetwork: eero.HealthDetail{Status: "yellow"},
+			},
+		},
+		{
+			name: "ZeroValue",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.health.IsHealthy(); got != tc.want {
+				t.Errorf("IsHealthy() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNetworkDetails_NodeHelpers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		body        string
+		wantNodes   bool
+		wantGateway string
+		gatewayErr  error
+		nodeErr     error
+		extenders   []string
+	}{
+		{
+			name:       "ZeroNodes_Placeholder",
+			body:       `{"meta": {"code": 200}, "data": {"name": "Cabin", "eeros": {"count": 0, "data": []}}}`,
+			gatewayErr: eero.ErrNoNodes,
+			nodeErr:    eero.ErrNoNodes,
+		},
+		{
+			name:       "ZeroNodes_MissingEeros",
+			body:       `{"meta": {"code": 200}, "data": {"name": "Cabin"}}`,
+			gatewayErr: eero.ErrNoNodes,
+			nodeErr:    eero.ErrNoNodes,
+		},
+		{
+			name:        "Mesh_WithGateway",
+			body:        `{"meta": {"code": 200}, "data": {"name": "Home", "eeros": {"count": 2, "data": [{"url": "/2.2/eeros/2", "location": "Office"}, {"url": "/2.2/eeros/1", "location": "Living Room", "gateway": true}]}}}`,
+			wantNodes:   true,
+			wantGateway: "/2.2/eeros/1",
+			nodeErr:     eero.ErrDeviceNotFound,
+			extenders:   []string{"/2.2/eeros/2"},
+		},
+		{
+			name:        "Mesh_PrimaryFallback",
+			body:        `{"meta": {"code": 200}, "data": {"name": "Home", "eeros": {"count": 3, "data": [{"url": "/2.2/eeros/1", "is_primary_node": true}, {"url": "/2.2/eeros/2"}, {"url": "/2.2/eeros/3"}]}}}`,
+			wantNodes:   true,
+			wantGateway: "/2.2/eeros/1",
+			nodeErr:     eero.ErrDeviceNotFound,
+			extenders:   []string{"/2.2/eeros/2", "/2.2/eeros/3"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			details, err := client.Network.Get(ctx, "/2.2/networks/44444")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := details.HasNodes(); got != tc.wantNodes {
+				t.Errorf("HasNodes() = %v, want %v", got, tc.wantNodes)
+			}
+
+			gw, err := details.GatewayNode()
+			if tc.gatewayErr != nil {
+				if !errors.Is(err, tc.gatewayErr) {
+					t.Errorf("GatewayNode() error = %v, want %v", err, tc.gatewayErr)
+				}
+			} else if err != nil || gw.URL != tc.wantGateway {
+				t.Errorf("GatewayNode() = %+v, %v; want %s", gw, err, tc.wantGateway)
+			}
+
+			if _, err := details.Node("/2.2/eeros/404"); !errors.Is(err, tc.nodeErr) {
+				t.Errorf("Node() error = %v, want %v", err, tc.nodeErr)
+			}
+
+			var extenders []string
+			for _, node := range details.ExtenderNodes() {
+				extenders = append(extenders, node.URL)
+			}
+			if strings.Join(extenders, ",") != strings.Join(tc.extenders, ",") {
+				t.Errorf("ExtenderNodes() = %v, want %v", extenders, tc.extenders)
+			}
+		})
+	}
+}
+
+func TestNetworkDetails_NodeForDevice(t *testing.T) {
+	t.Parallel()
+
+	var details eero.NetworkDetails
+	body := `{"eeros": {"count": 2, "data": [
+		{"url": "/2.2/eeros/1", "serial": "GGC1", "location": "Living Room", "gateway": true},
+		{"url": "/2.2/eeros/2", "serial": "GGC2", "location": "Office"}
+	]}}`
+	if err := json.Unmarshal([]byte(body), &details); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		source   eero.DeviceSource
+		wantNode string
+	}{
+		{name: "ByURL", source: eero.DeviceSource{URL: "/2.2/eeros/2"}, wantNode: "Office"},
+		{name: "BySerial", source: eero.DeviceSource{SerialNumber: "GGC1"}, wantNode: "Living Room"},
+		{name: "UnknownNode", source: eero.DeviceSource{URL: "/2.2/eeros/9", SerialNumber: "GGC9"}},
+		{name: "NoSource"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			node, ok := details.NodeForDevice(eero.Device{MAC: "aa:bb:cc:dd:ee:ff", Source: tc.source})
+			if tc.wantNode == "" {
+				if ok || node != nil {
+					t.Errorf("Expected no node, got %+v", node)
+				}
+				return
+			}
+			if !ok || node.Location != tc.wantNode {
+				t.Errorf("Expected node %q, got %+v (ok=%v)", tc.wantNode, node, ok)
+			}
+		})
+	}
+}
+
+func TestEeroNode_Telemetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		payload  string
+		validate func(t *testing.T, n eero.EeroNode)
+	}{
+		{
+			name: "WithTelemetry",
+			payload: `{"url": "/2.2/eeros/1", "model": "eero Pro 6E", "telemetry": {
+				"cpu_temperature": 61.5,
+				"cpu_load": 12,
+				"radios": [
+					{"band": "2.4GHz", "channel": 6, "tx_power": 20},
+					{"band": "5GHz", "channel": 149, "tx_power": 23.5},
+					{"band": "6GHz", "channel": 37}
+				]
+			}}`,
+			validate: func(t *testing.T, n eero.EeroNode) {
+				tel := n.Telemetry
+				if tel == nil {
+					t.Fatal("Expected telemetry to be decoded")
+				}
+				if tel.CPUTemperature == nil || *tel.CPUTemperature != 61.5 {
+					t.Errorf("Expected CPU temperature 61.5, got %v", tel.CPUTemperature)
+				}
+				if tel.CPULoad == nil || *tel.CPULoad != 12 {
+					t.Errorf("Expected CPU load 12, got %v", tel.CPULoad)
+				}
+				if len(tel.Radios) != 3 {
+					t.Fatalf("Expected 3 radios, got %d", len(tel.Radios))
+				}
+				if r := tel.Radios[1]; r.Band != "5GHz" || r.Channel != 149 || r.TxPower == nil || *r.TxPower != 23.5 {
+					t.Errorf("Unexpected 5GHz radio: %+v", r)
+				}
+				if tel.Radios[2].TxPower != nil {
+					t.Errorf("Expected nil tx power for a radio that omits it, got %v", *tel.Radios[2].TxPower)
+				}
+			},
+		},
+		{
+			name:    "WithoutTelemetry",
+			payload: `{"url": "/2.2/eeros/2", "model": "eero"}`,
+			validate: func(t *testing.T, n eero.EeroNode) {
+				if n.Telemetry != nil {
+					t.Errorf("Expected nil telemetry, got %+v", n.Telemetry)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var n eero.EeroNode
+			if err := json.Unmarshal([]byte(tc.payload), &n); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			tc.validate(t, n)
+		})
+	}
+}
+
+func TestNetworkService_ListForwards(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444/forwards", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{"url": "/2.2/networks/44444/forwards/1", "gateway_port": 32400, "client_port": 32400, "protocol": "tcp", "ip": "192.168.4.20", "description": "Plex"}
+			]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	rules, err := client.Network.ListForwards(ctx, "/2.2/networks/44444")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+	if r := rules[0]; r.ExternalPort != 32400 || r.Protocol != eero.ForwardTCP || r.IP != "192.168.4.20" || r.URL == "" {
+		t.Errorf("Unexpected rule: %+v", r)
+	}
+}
+
+func TestNetworkService_CreateForward(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		rule        eero.ForwardRule
+		expectBody  string
+		wantInvalid bool
+	}{
+		{
+			name:       "Success_IPMirrorsInternalPort",
+			rule:       eero.ForwardRule{ExternalPort: 25565, Protocol: eero.ForwardTCP, IP: "192.168.4.20", Description: "Minecraft"},
+			expectBody: `{"gateway_port":25565,"client_port":25565,"protocol":"tcp","ip":"192.168.4.20","description":"Minecraft"}`,
+		},
+		{
+			name:       "Success_MACBoth",
+			rule:       eero.ForwardRule{ExternalPort: 51820, InternalPort: 51820, Protocol: eero.ForwardBoth, MAC: "aa:bb:cc:dd:ee:01", Description: "VPN"},
+			expectBody: `{"gateway_port":51820,"client_port":51820,"protocol":"both","mac":"aa:bb:cc:dd:ee:01","description":"VPN"}`,
+		},
+		{
+			name:        "Failure_BothWithOnePort",
+			rule:        eero.ForwardRule{ExternalPort: 8080, Protocol: eero.ForwardBoth, IP: "192.168.4.20"},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_PortOutOfRange",
+			rule:        eero.ForwardRule{ExternalPort: 70000, Protocol: eero.ForwardUDP, IP: "192.168.4.20"},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_UnknownProtocol",
+			rule:        eero.ForwardRule{ExternalPort: 80, Protocol: "sctp", IP: "192.168.4.20"},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_NoTarget",
+			rule:        eero.ForwardRule{ExternalPort: 80, Protocol: eero.ForwardTCP},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_IPAndMAC",
+			rule:        eero.ForwardRule{ExternalPort: 80, Protocol: eero.ForwardTCP, IP: "192.168.4.20", MAC: "aa:bb:cc:dd:ee:01"},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_IPOutsideLAN",
+			rule:        eero.ForwardRule{ExternalPort: 80, Protocol: eero.ForwardTCP, IP: "10.0.0.5"},
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var posts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"lease": {"mode": "dhcp", "dhcp": {"ip": "192.168.4.1", "mask": "255.255.255.0", "router": "192.168.4.1"}}}}`))
+			})
+			mux.HandleFunc("/2.2/networks/44444/forwards", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&posts, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "/2.2/networks/44444/forwards/7", "gateway_port": 25565, "client_port": 25565, "protocol": "tcp"}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			created, err := client.Network.CreateForward(ctx, "/2.2/networks/44444", tc.rule)
+
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&posts); n != 0 {
+					t.Errorf("Expected no POST, got %d", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if created.URL != "/2.2/networks/44444/forwards/7" {
+				t.Errorf("Expected forward URL, got %q", created.URL)
+			}
+		})
+	}
+}
+
+func TestNetworkService_DeleteForward(t *testing.T) {
+	t.Parallel()
+
+	forwardURL := "/2.2/networks/44444/forwards/7"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(forwardURL, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	if err := client.Network.DeleteForward(context.Background(), forwardURL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestNetworkService_SetName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		networkName string
+		expectBody  string
+		wantInvalid bool
+	}{
+		{
+			name:        "Success",
+			networkName: "Home Mesh",
+			expectBody:  `{"name":"Home Mesh"}`,
+		},
+		{
+			name:        "Failure_Empty",
+			networkName: "  ",
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_TooLong",
+			networkName: strings.Repeat("x", 33),
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var puts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&puts, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.SetName(ctx, "/2.2/networks/44444", tc.networkName)
+
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&puts); n != 0 {
+					t.Errorf("Expected no PUT, got %d", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNetworkService_SetNodeLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		location    string
+		expectBody  string
+		wantInvalid bool
+	}{
+		{
+			name:       "Success",
+			location:   "Upstairs Office",
+			expectBody: `{"location":"Upstairs Office"}`,
+		},
+		{
+			name:        "Failure_Empty",
+			location:    " ",
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var puts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/eeros/67890", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&puts, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Network.SetNodeLocation(ctx, "/2.2/eeros/67890", tc.location)
+
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&puts); n != 0 {
+					t.Errorf("Expected no PUT, got %d", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := atomic.LoadInt32(&puts); n != 1 {
+				t.Errorf("Expected 1 PUT, got %d", n)
+			}
+		})
+	}
+}
+
+func TestNetworkService_SettingToggles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, c *eero.Client) (string, error)
+		expectBody  string
+		respMeta    string
+		wantWarning string
+	}{
+		{
+			name: "UPnP",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return "", c.Network.SetUPnP(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"upnp":true}`,
+		},
+		{
+			name: "SQM",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return "", c.Network.SetSQM(ctx, "/2.2/networks/44444", false)
+			},
+			expectBody: `{"sqm":false}`,
+		},
+		{
+			name: "BandSteering",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return "", c.Network.SetBandSteering(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"band_steering":true}`,
+		},
+		{
+			name: "IPv6Upstream",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return "", c.Network.SetIPv6Upstream(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"ipv6_upstream":true}`,
+		},
+		{
+			name: "WPA3_WithWarning",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return c.Network.SetWPA3(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody:  `{"wpa3":true}`,
+			respMeta:    `, "warning": "Your network will restart to apply this change"`,
+			wantWarning: "Your network will restart to apply this change",
+		},
+		{
+			name: "WPA3_NoWarning",
+			call: func(ctx context.Context, c *eero.Client) (string, error) {
+				return c.Network.SetWPA3(ctx, "/2.2/networks/44444", false)
+			},
+			expectBody: `{"wpa3":false}`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200` + tc.respMeta + `}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			warning, err := tc.call(ctx, client)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if warning != tc.wantWarning {
+				t.Errorf("Expected warning %q, got %q", tc.wantWarning, warning)
+			}
+		})
+	}
+}
+
+func TestNetworkService_SetDNSPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		call           func(ctx context.Context, c *eero.Client) error
+		expectBody     string
+		respStatus     int
+		respBody       string
+		wantErr        bool
+		wantPremiumErr bool
+	}{
+		{
+			name: "AdBlock_Success",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetAdBlock(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"ad_block":true}`,
+			respStatus: http.StatusOK,
+			respBody:   `{"meta": {"code": 200}, "data": {}}`,
+		},
+		{
+			name: "MalwareBlock_Success",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetMalwareBlock(ctx, "/2.2/networks/44444", false)
+			},
+			expectBody: `{"block_malware":false}`,
+			respStatus: http.StatusOK,
+			respBody:   `{"meta": {"code": 200}, "data": {}}`,
+		},
+		{
+			name: "AdBlock_PaymentRequired",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetAdBlock(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody:     `{"ad_block":true}`,
+			respStatus:     http.StatusPaymentRequired,
+			respBody:       `{"meta": {"code": 402, "error": "premium.required"}, "data": {}}`,
+			wantErr:        true,
+			wantPremiumErr: true,
+		},
+		{
+			name: "MalwareBlock_Forbidden",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetMalwareBlock(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody:     `{"block_malware":true}`,
+			respStatus:     http.StatusForbidden,
+			respBody:       `{"meta": {"code": 403, "error": "Subscription required"}, "data": {}}`,
+			wantErr:        true,
+			wantPremiumErr: true,
+		},
+		{
+			name: "MalwareBlock_PlainForbidden",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetMalwareBlock(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"block_malware":true}`,
+			respStatus: http.StatusForbidden,
+			respBody:   `{"meta": {"code": 403, "error": "Forbidden"}, "data": {}}`,
+			wantErr:    true,
+		},
+		{
+			name: "AdBlock_ServerError",
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.SetAdBlock(ctx, "/2.2/networks/44444", true)
+			},
+			expectBody: `{"ad_block":true}`,
+			respStatus: http.StatusInternalServerError,
+			respBody:   `{"meta": {"code": 500, "error": "Internal Server Error"}, "data": {}}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/dns_policies/network", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(tc.respStatus)
+				_, _ = w.Write([]byte(tc.respBody))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := tc.call(ctx, client)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got := errors.Is(err, eero.ErrPremiumRequired); got != tc.wantPremiumErr {
+				t.Errorf("errors.Is(err, ErrPremiumRequired) = %v, want %v (err: %v)", got, tc.wantPremiumErr, err)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != tc.respStatus {
+					t.Errorf("Expected *APIError with status %d, got %v", tc.respStatus, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNetworkService_SetDNS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		servers     []string
+		reset       bool
+		expectBody  string
+		wantInvalid bool
+	}{
+		{
+			name:       "Success_CustomIPv4",
+			servers:    []string{"1.1.1.1", "1.0.0.1"},
+			expectBody: `{"mode":"custom","custom":{"ips":["1.1.1.1","1.0.0.1"]},"ipv6":{"mode":"automatic"}}`,
+		},
+		{
+			name:       "Success_CustomDualStack",
+			servers:    []string{"9.9.9.9", "2620:FE::FE"},
+			expectBody: `{"mode":"custom","custom":{"ips":["9.9.9.9"]},"ipv6":{"mode":"custom","custom":{"ips":["2620:fe::fe"]}}}`,
+		},
+		{
+			name:       "Success_ResetToAutomatic",
+			reset:      true,
+			expectBody: `{"mode":"automatic","ipv6":{"mode":"automatic"}}`,
+		},
+		{
+			name:        "Failure_Empty",
+			servers:     nil,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_NotAnIP",
+			servers:     []string{"dns.google"},
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_TooManyIPv4",
+			servers:     []string{"1.1.1.1", "1.0.0.1", "8.8.8.8"},
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var puts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/dns", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&puts, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			var err error
+			if tc.reset {
+				err = client.Network.ResetDNS(ctx, "/2.2/networks/44444")
+			} else {
+				err = client.Network.SetDNS(ctx, "/2.2/networks/44444", tc.servers)
+			}
+
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&puts); n != 0 {
+					t.Errorf("Expected no PUT, got %d", n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := atomic.LoadInt32(&puts); n != 1 {
+				t.Errorf("Expected 1 PUT, got %d", n)
+			}
+		})
+	}
+}
+
+func TestNetworkService_PauseAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NativeEndpoint", func(t *testing.T) {
+		t.Parallel()
+
+		var bodies []string
+		var mu sync.Mutex
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2.2/networks/44444/pause", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
+			}
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+		mux.HandleFunc("/2.2/networks/44444/profiles", func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("Profiles must not be touched when the native endpoint works")
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := eero.NewClient()
+		client.BaseURL = server.URL + "/2.2"
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		if err := client.Network.PauseAll(ctx, "/2.2/networks/44444"); err != nil {
+			t.Fatalf("PauseAll: %v", err)
+		}
+		if err := client.Network.ResumeAll(ctx, "/2.2/networks/44444"); err != nil {
+			t.Fatalf("ResumeAll: %v", err)
+		}
+
+		want := []string{`{"paused":true}`, `{"paused":false}`}
+		if fmt.Sprint(bodies) != fmt.Sprint(want) {
+			t.Errorf("Expected bodies %v, got %v", want, bodies)
+		}
+	})
+
+	t.Run("ProfileFallback", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		puts := make(map[string][]string)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2.2/networks/44444/pause", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"meta": {"code": 404, "error": "Not Found"}, "data": {}}`))
+		})
+		mux.HandleFunc("/2.2/networks/44444/profiles", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [
+				{"url": "/2.2/networks/44444/profiles/1", "name": "Kids", "paused": false},
+				{"url": "/2.2/networks/44444/profiles/2", "name": "Guests", "paused": true},
+				{"url": "/2.2/networks/44444/profiles/3", "name": "Teens", "paused": false}
+			]}`))
+		})
+		mux.HandleFunc("/2.2/networks/44444/profiles/", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
+			}
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			puts[r.URL.Path] = append(puts[r.URL.Path], string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := eero.NewClient()
+		client.BaseURL = server.URL + "/2.2"
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		if err := client.Network.PauseAll(ctx, "/2.2/networks/44444"); err != nil {
+			t.Fatalf("PauseAll: %v", err)
+		}
+		if err := client.Network.ResumeAll(ctx, "/2.2/networks/44444"); err != nil {
+			t.Fatalf("ResumeAll: %v", err)
+		}
+
+		want := map[string][]string{
+			"/2.2/networks/44444/profiles/1": {`{"paused":true}`, `{"paused":false}`},
+			"/2.2/networks/44444/profiles/3": {`{"paused":true}`, `{"paused":false}`},
+		}
+		if fmt.Sprint(puts) != fmt.Sprint(want) {
+			t.Errorf("Expected profile PUTs %v, got %v", want, puts)
+		}
+
+		// A second ResumeAll has nothing recorded and must not touch profiles.
+		if err := client.Network.ResumeAll(ctx, "/2.2/networks/44444"); err != nil {
+			t.Fatalf("second ResumeAll: %v", err)
+		}
+		if fmt.Sprint(puts) != fmt.Sprint(want) {
+			t.Errorf("Expected no further PUTs, got %v", puts)
+		}
+	})
+
+	t.Run("FallbackBoundsConcurrency", func(t *testing.T) {
+		t.Parallel()
+
+		var inFlight, maxInFlight, puts int32
+		var list strings.Builder
+		for i := 0; i < 20; i++ {
+			if i > 0 {
+				list.WriteString(",")
+			}
+			fmt.Fprintf(&list, `{"url": "/2.2/networks/44444/profiles/%d", "paused": false}`, i)
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/2.2/networks/44444/pause", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"meta": {"code": 404, "error": "Not Found"}, "data": {}}`))
+		})
+		mux.HandleFunc("/2.2/networks/44444/profiles", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"meta": {"code": 200}, "data": [%s]}`, list.String())
+		})
+		mux.HandleFunc("/2.2/networks/44444/profiles/", func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			atomic.AddInt32(&puts, 1)
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := eero.NewClient()
+		client.BaseURL = server.URL + "/2.2"
+
+		if err := client.Network.PauseAll(context.Background(), "/2.2/networks/44444"); err != nil {
+			t.Fatalf("PauseAll: %v", err)
+		}
+		if got := atomic.LoadInt32(&puts); got != 20 {
+			t.Errorf("Expected 20 profile PUTs, got %d", got)
+		}
+		if got := atomic.LoadInt32(&maxInFlight); got > 5 {
+			t.Errorf("Expected at most 5 concurrent PUTs, got %d", got)
+		}
+	})
+}
+
+func TestPremiumDNS_Zscaler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		payload  string
+		wantOK   bool
+		wantName string
+		wantIPs  int
+	}{
+		{
+			name:     "Enabled",
+			payload:  `{"zscaler_location_enabled": true, "zscaler_location": {"id": 81234567, "name": "HQ", "country": "US", "ip_addresses": ["203.0.113.10", "203.0.113.11"]}}`,
+			wantOK:   true,
+			wantName: "HQ",
+			wantIPs:  2,
+		},
+		{
+			name:    "Disabled_WithStaleDetails",
+			payload: `{"zscaler_location_enabled": false, "zscaler_location": {"id": 81234567, "name": "HQ"}}`,
+		},
+		{
+			name:    "Absent",
+			payload: `{"dns_policies_enabled": true}`,
+		},
+		{
+			name:    "EnabledButNull",
+			payload: `{"zscaler_location_enabled": true, "zscaler_location": null}`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var dns eero.PremiumDNS
+			if err := json.Unmarshal([]byte(tc.payload), &dns); err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+
+			loc, ok := dns.Zscaler()
+			if ok != tc.wantOK {
+				t.Fatalf("Zscaler() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !ok {
+				if loc != nil {
+					t.Errorf("Expected nil location, got %+v", loc)
+				}
+				return
+			}
+			if loc.Name != tc.wantName || len(loc.IPAddresses) != tc.wantIPs || loc.ID != 81234567 {
+				t.Errorf("Unexpected location: %+v", loc)
+			}
+		})
+	}
+}
+
+func TestNetworkDetails_ConnectionModeTyped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw        string
+		want       eero.ConnectionMode
+		wantBridge bool
+	}{
+		{raw: "automatic", want: eero.ConnectionModeRouter},
+		{raw: "manual", want: eero.ConnectionModeManual},
+		{raw: "PPPOE", want: eero.ConnectionModeManual},
+		{raw: "bridge", want: eero.ConnectionModeBridge, wantBridge: true},
+		{raw: "", want: eero.ConnectionModeUnknown},
+		{raw: "mesh_only", want: eero.ConnectionModeUnknown},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.raw, func(t *testing.T) {
+			t.Parallel()
+
+			var details eero.NetworkDetails
+			payload := `{"connection": {"mode": "` + tc.raw + `"}}`
+			if err := json.Unmarshal([]byte(payload), &details); err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+			if got := details.ConnectionModeTyped(); got != tc.want {
+				t.Errorf("ConnectionModeTyped() = %q, want %q", got, tc.want)
+			}
+			if got := errors.Is(details.RequireRouterMode(), eero.ErrBridgeMode); got != tc.wantBridge {
+				t.Errorf("RequireRouterMode() is ErrBridgeMode = %v, want %v", got, tc.wantBridge)
+			}
+		})
+	}
+}
+
+func TestBridgeMode_GatesRoutingFeatures(t *testing.T) {
+	t.Parallel()
+
+	var posts int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"connection": {"mode": "bridge"}}}`))
+	})
+	mux.HandleFunc("/2.2/networks/44444/forwards", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+	})
+	mux.HandleFunc("/2.2/networks/44444/reservations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&posts, 1)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	rule := eero.ForwardRule{ExternalPort: 80, Protocol: eero.ForwardTCP, MAC: "aa:bb:cc:dd:ee:01"}
+	if _, err := client.Network.CreateForward(ctx, "/2.2/networks/44444", rule); !errors.Is(err, eero.ErrBridgeMode) {
+		t.Errorf("CreateForward: expected ErrBridgeMode, got %v", err)
+	}
+	if _, err := client.Reservation.Create(ctx, "/2.2/networks/44444", "aa:bb:cc:dd:ee:01", "192.168.4.10", ""); !errors.Is(err, eero.ErrBridgeMode) {
+		t.Errorf("Reservation.Create: expected ErrBridgeMode, got %v", err)
+	}
+	if n := atomic.LoadInt32(&posts); n != 0 {
+		t.Errorf("Expected no POSTs in bridge mode, got %d", n)
+	}
+}
+
+func TestNetworkService_IfMatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		etag = `"v1"`
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "/2.2/networks/55555", "name": "Home"}}`))
+		case http.MethodPut:
+			if got := r.Header.Get("If-Match"); got != "" && got != etag {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"meta": {"code": 412, "error": "precondition failed"}}`))
+				return
+			}
+			etag = fmt.Sprintf(`"v%d"`, len(etag))
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	stale, err := client.Network.Get(ctx, "/2.2/networks/55555")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if stale.ETag != `"v1"` {
+		t.Fatalf("Expected ETag %q, got %q", `"v1"`, stale.ETag)
+	}
+
+	// Another admin changes the network without a precondition.
+	if err := client.Network.SetName(ctx, "/2.2/networks/55555", "Other"); err != nil {
+		t.Fatalf("unconditional SetName() error = %v", err)
+	}
+
+	err = client.Network.SetName(eero.WithIfMatch(ctx, stale.ETag), "/2.2/networks/55555", "Mine")
+	if !errors.Is(err, eero.ErrConflict) {
+		t.Fatalf("Expected ErrConflict for a stale ETag, got %v", err)
+	}
+	var apiErr *eero.APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsConflict() {
+		t.Errorf("Expected an *APIError reporting IsConflict, got %v", err)
+	}
+
+	fresh, err := client.Network.Get(ctx, "/2.2/networks/55555")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := client.Network.SetName(eero.WithIfMatch(ctx, fresh.ETag), "/2.2/networks/55555", "Mine"); err != nil {
+		t.Errorf("SetName() with fresh ETag error = %v", err)
+	}
+}
+
+func TestNetworkService_DataUsage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		window      string
+		status      int
+		body        string
+		wantCadence string
+		wantSpan    time.Duration
+		wantDown    int64
+		wantDevices int
+		wantErr     error
+		// wantNotPremium expects an *APIError that does not match
+		// ErrPremiumRequired.
+		wantNotPremium bool
+		wantCalls      int32
+	}{
+		{
+			name:        "Success_Week",
+			window:      eero.UsageWindowWeek,
+			status:      http.StatusOK,
+			body:        `{"meta": {"code": 200}, "data": {"download": 5000, "upload": 700, "devices": [{"url": "/2.2/networks/12345/devices/aabbcc", "mac": "aa:bb:cc:dd:ee:ff", "display_name": "Laptop", "download": 4000, "upload": 500}, {"mac": "11:22:33:44:55:66", "download": 1000, "upload": 200}]}}`,
+			wantCadence: "daily",
+			wantSpan:    7 * 24 * time.Hour,
+			wantDown:    5000,
+			wantDevices: 2,
+			wantCalls:   1,
+		},
+		{
+			name:        "Success_Day",
+			window:      eero.UsageWindowDay,
+			status:      http.StatusOK,
+			body:        `{"meta": {"code": 200}, "data": {"download": 10, "upload": 1, "devices": []}}`,
+			wantCadence: "hourly",
+			wantSpan:    24 * time.Hour,
+			wantDown:    10,
+			wantCalls:   1,
+		},
+		{
+			name:        "Failure_PremiumRequired",
+			window:      eero.UsageWindowMonth,
+			status:      http.StatusPaymentRequired,
+			body:        `{"meta": {"code": 402, "error": "eero plus required"}}`,
+			wantCadence: "daily",
+			wantSpan:    30 * 24 * time.Hour,
+			wantErr:     eero.ErrPremiumRequired,
+			wantCalls:   1,
+		},
+		{
+			name:           "Failure_PlainForbidden",
+			window:         eero.UsageWindowWeek,
+			status:         http.StatusForbidden,
+			body:           `{"meta": {"code": 403, "error": "not allowed"}}`,
+			wantCadence:    "daily",
+			wantSpan:       7 * 24 * time.Hour,
+			wantNotPremium: true,
+			wantCalls:      1,
+		},
+		{
+			name:    "Failure_UnknownWindow",
+			window:  "year",
+			wantErr: eero.ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/12345/data_usage/breakdown", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				q := r.URL.Query()
+				if got := q.Get("cadence"); got != tc.wantCadence {
+					t.Errorf("Expected cadence %q, got %q", tc.wantCadence, got)
+				}
+				start, err1 := time.Parse(time.RFC3339, q.Get("start"))
+				end, err2 := time.Parse(time.RFC3339, q.Get("end"))
+				if err1 != nil || err2 != nil {
+					t.Errorf("Expected RFC 3339 start/end, got %q/%q", q.Get("start"), q.Get("end"))
+				} else if span := end.Sub(start); span != tc.wantSpan {
+					t.Errorf("Expected window %s, got %s", tc.wantSpan, span)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			usage, err := client.Network.DataUsage(context.Background(), "/2.2/networks/12345", tc.window)
+			if got := atomic.LoadInt32(&calls); got != tc.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tc.wantCalls, got)
+			}
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("Expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if tc.wantNotPremium {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || errors.Is(err, eero.ErrPremiumRequired) {
+					t.Fatalf("Expected a non-premium *APIError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DataUsage() error = %v", err)
+			}
+			if usage.Download != tc.wantDown || len(usage.Devices) != tc.wantDevices {
+				t.Errorf("Unexpected usage: %+v", usage)
+			}
+		})
+	}
+}
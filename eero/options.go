@@ -0,0 +1,346 @@
+package eero
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client at construction time. Options are applied in
+// order by NewClient, which validates all of them and reports every invalid
+// option in a single error.
+type Option func(*Client) error
+
+// WithBaseURL sets the root URL for all API requests (default
+// DefaultBaseURL). The URL must be absolute, use http or https, and include a
+// host. A trailing slash is removed so that paths can be appended directly.
+func WithBaseURL(rawURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("WithBaseURL: %w", err)
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return fmt.Errorf("WithBaseURL: unsupported scheme %q in %q", u.Scheme, rawURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("WithBaseURL: missing host in %q", rawURL)
+		}
+		c.BaseURL = strings.TrimSuffix(rawURL, "/")
+		return nil
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request
+// (default DefaultUserAgent).
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		if strings.TrimSpace(userAgent) == "" {
+			return errors.New("WithUserAgent: user agent is empty")
+		}
+		if strings.ContainsAny(userAgent, "\r\n") {
+			return errors.New("WithUserAgent: user agent contains a line break")
+		}
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithUserAgentSuffix replaces the token appended to the User-Agent header
+// (default "eero-go/<Version>"). Pass an empty string to send UserAgent
+// unmodified.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(c *Client) error {
+		if strings.ContainsAny(suffix, "\r\n") {
+			return errors.New("WithUserAgentSuffix: suffix contains a line break")
+		}
+		c.userAgentSuffix = strings.TrimSpace(suffix)
+		return nil
+	}
+}
+
+// WithCookieName changes the name of the session cookie set by
+// SetSessionCookie (and therefore Auth.Login) and read by SessionCookie
+// (default DefaultCookieName, "s"). It is useful against mock servers, or if
+// eero renames the cookie. The name must be a valid HTTP token.
+func WithCookieName(name string) Option {
+	return func(c *Client) error {
+		if name == "" {
+			return errors.New("WithCookieName: name is empty")
+		}
+		for i := 0; i < len(name); i++ {
+			if !isTokenByte(name[i]) {
+				return fmt.Errorf("WithCookieName: invalid character %q in %q", name[i], name)
+			}
+		}
+		c.cookieName = name
+		return nil
+	}
+}
+
+// isTokenByte reports whether b may appear in an HTTP token (RFC 7230
+// section 3.2.6), the syntax of a cookie name.
+func isTokenByte(b byte) bool {
+	return 0x20 < b && b < 0x7f && !strings.ContainsRune(`()<>@,;:\"/[]?={}`, rune(b))
+}
+
+// WithHTTPClient replaces the underlying *http.Client. The supplied client is
+// copied, never mutated. If it has no cookie jar or redirect policy, the
+// client's defaults are kept so that session handling and the cross-domain
+// redirect guard remain in force.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) error {
+		if hc == nil {
+			return errors.New("WithHTTPClient: client is nil")
+		}
+		cp := *hc
+		if cp.Jar == nil {
+			cp.Jar = c.HTTPClient.Jar
+		}
+		if cp.CheckRedirect == nil {
+			cp.CheckRedirect = c.HTTPClient.CheckRedirect
+		}
+		c.HTTPClient = &cp
+		return nil
+	}
+}
+
+// WithTransport installs HTTP middleware around the client's transport, for
+// example OpenTelemetry spans or metrics. wrap receives the current transport
+// — by default the tuned *http.Transport created by NewClient, or the one
+// from an earlier WithHTTPClient — and returns the RoundTripper to use in its
+// place, which should delegate to base:
+//
+//	eero.WithTransport(func(base http.RoundTripper) http.RoundTripper {
+//		return otelhttp.NewTransport(base)
+//	})
+//
+// Only the transport is wrapped; the cookie jar, redirect policy and timeout
+// configured on the *http.Client are left intact. Multiple WithTransport
+// options nest in order, the last one outermost.
+func WithTransport(wrap func(base http.RoundTripper) http.RoundTripper) Option {
+	return func(c *Client) error {
+		if wrap == nil {
+			return errors.New("WithTransport: wrap is nil")
+		}
+		base := c.HTTPClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		rt := wrap(base)
+		if rt == nil {
+			return errors.New("WithTransport: wrap returned a nil RoundTripper")
+		}
+		c.HTTPClient.Transport = rt
+		return nil
+	}
+}
+
+// WithTimeout sets the fallback timeout for an entire HTTP exchange
+// (default 30 seconds). Per-call deadlines should still be set on the
+// context passed to each service method; this only acts as a safety net.
+// A single call that needs longer can override it with WithRequestTimeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("WithTimeout: timeout must be positive, got %s", d)
+		}
+		c.HTTPClient.Timeout = d
+		return nil
+	}
+}
+
+// WithMaxIdleConnsPerHost sets how many idle keep-alive connections the
+// transport retains per host (default 10). A server polling many networks
+// concurrently can raise it toward its concurrency level to avoid
+// reconnecting; values between 2 and 100 are sensible, since every request
+// goes to the same API host. The overall idle pool (default 100) is grown to
+// at least n. n must be positive.
+//
+// Like the other transport tuning options, it adjusts a copy of the
+// *http.Transport created by NewClient (or supplied through WithHTTPClient),
+// keeping the redirect guard and cookie jar, and must come before any
+// WithTransport, whose wrapper hides the transport.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return tuneTransport("WithMaxIdleConnsPerHost", func(t *http.Transport) error {
+		if n <= 0 {
+			return fmt.Errorf("must be positive, got %d", n)
+		}
+		t.MaxIdleConnsPerHost = n
+		if t.MaxIdleConns != 0 && t.MaxIdleConns < n {
+			t.MaxIdleConns = n
+		}
+		return nil
+	})
+}
+
+// WithIdleConnTimeout sets how long an idle keep-alive connection is kept
+// before being closed (default 90 seconds). Between 30 seconds and a few
+// minutes is sensible: long enough to span a polling interval, short enough
+// not to outlive the server's or a proxy's own idle timeout. d must be
+// positive.
+func WithIdleConnTimeout(d time.Duration) Option {
+	return tuneTransport("WithIdleConnTimeout", func(t *http.Transport) error {
+		if d <= 0 {
+			return fmt.Errorf("must be positive, got %s", d)
+		}
+		t.IdleConnTimeout = d
+		return nil
+	})
+}
+
+// WithTLSHandshakeTimeout bounds the TLS handshake of each new connection
+// (default 10 seconds). Between 5 and 30 seconds is sensible; much lower
+// risks failing on slow or lossy links. d must be positive.
+func WithTLSHandshakeTimeout(d time.Duration) Option {
+	return tuneTransport("WithTLSHandshakeTimeout", func(t *http.Transport) error {
+		if d <= 0 {
+			return fmt.Errorf("must be positive, got %s", d)
+		}
+		t.TLSHandshakeTimeout = d
+		return nil
+	})
+}
+
+// tuneTransport returns an Option that applies set to a clone of the
+// client's *http.Transport, so a transport shared through WithHTTPClient is
+// never mutated.
+func tuneTransport(name string, set func(*http.Transport) error) Option {
+	return func(c *Client) error {
+		t, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("%s: transport is %T, not *http.Transport; apply it before WithTransport", name, c.HTTPClient.Transport)
+		}
+		t = t.Clone()
+		if err := set(t); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		c.HTTPClient.Transport = t
+		return nil
+	}
+}
+
+// Values mimicking the headers sent by the official eero app. They are only
+// attached when WithAppHeaders is supplied.
+const (
+	appOrigin     = "https://app.eero.com"
+	appReferer    = "https://app.eero.com/"
+	appPlatform   = "ios"
+	appAppVersion = "3.0"
+)
+
+// WithAppHeaders attaches a consistent set of headers resembling the official
+// eero app (Origin, Referer, X-Eero-Platform, X-Eero-App-Version) to every
+// request. Some endpoints behave differently, or reject requests outright,
+// when these are missing.
+//
+// Each header is set exactly once per request and never overrides a value the
+// request already carries (such as User-Agent or Content-Type).
+func WithAppHeaders() Option {
+	return func(c *Client) error {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Set("Origin", appOrigin)
+		c.headers.Set("Referer", appReferer)
+		c.headers.Set("X-Eero-Platform", appPlatform)
+		c.headers.Set("X-Eero-App-Version", appAppVersion)
+		return nil
+	}
+}
+
+// WithForceJSONContentType makes the client send
+// "Content-Type: application/json" on POST, PUT and PATCH requests even when
+// they carry no body (for example Network.Reboot). By default the header is
+// only set when a body is present; some stricter eero endpoints reject
+// bodiless mutations without it.
+func WithForceJSONContentType() Option {
+	return func(c *Client) error {
+		c.forceJSONContentType = true
+		return nil
+	}
+}
+
+// WithDebugDump writes a pretty-printed JSON view of every successfully
+// decoded response to w, preceded by the request method and path. It is
+// intended for diagnosing why a struct field comes back empty: the dump shows
+// what the client actually decoded, not the raw body.
+//
+// Values of fields whose names mention a token, cookie or password are
+// replaced with "[REDACTED]" so the dump never contains session credentials.
+// Writes are serialized, and write errors are ignored.
+func WithDebugDump(w io.Writer) Option {
+	return func(c *Client) error {
+		if w == nil {
+			return errors.New("WithDebugDump: writer is nil")
+		}
+		c.debugDump = w
+		return nil
+	}
+}
+
+// WithRequireContextDeadline makes every method fail with an error wrapping
+// ErrNoDeadline, without sending anything, when called with a context that
+// has no deadline (such as context.Background()). Without a deadline only
+// the HTTP client's fallback timeout bounds a call; this option catches such
+// calls during development. It is off by default.
+func WithRequireContextDeadline() Option {
+	return func(c *Client) error {
+		c.requireDeadline = true
+		return nil
+	}
+}
+
+// WithLogger registers a hook called once after every API request completes,
+// including any retries, with the request's context, method, URL, final HTTP
+// status (0 when no response was received) and total duration. It allows
+// structured logging or tracing without wrapping the HTTP client.
+//
+// The hook never sees request headers, so the session Cookie cannot leak
+// through it, and the URL has user info and token-bearing query parameters
+// (such as the "s" session value) redacted. The hook runs synchronously on
+// the calling goroutine and should return quickly. Without this option no
+// hook runs and no timing is recorded.
+func WithLogger(logger func(ctx context.Context, method, url string, status int, dur time.Duration)) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("WithLogger: logger is nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithHeaderAuth sends the session token in an "X-User-Token" request header
+// instead of the session cookie. SetSessionCookie, Auth.Login and
+// WithSessionStore then record the token on the client rather than in the
+// cookie jar, and an expiry given to SetSessionCookieWithExpiry is enforced
+// by no longer sending the header. This suits proxies and tests, since it
+// does not depend on the jar honoring the cookie's Secure attribute over
+// plain HTTP.
+func WithHeaderAuth() Option {
+	return func(c *Client) error {
+		c.headerAuth = true
+		return nil
+	}
+}
+
+// WithSessionStore makes the client persist its session token through
+// store. NewClient loads the saved token and sets it as the session cookie
+// (a missing or invalid token just leaves the client unauthenticated), and
+// every successful Auth.Verify saves the verified token. Use
+// FileSessionStore to keep it in a 0600 JSON file.
+func WithSessionStore(store SessionStore) Option {
+	return func(c *Client) error {
+		if store == nil {
+			return errors.New("WithSessionStore: store is nil")
+		}
+		c.sessionStore = store
+		return nil
+	}
+}
@@ -0,0 +1,674 @@
+package eero_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestWithAppHeaders(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, key := range []string{"Origin", "Referer", "X-Eero-Platform", "X-Eero-App-Version"} {
+			if got := r.Header.Values(key); len(got) != 1 || got[0] == "" {
+				t.Errorf("Expected exactly one %s header, got %q", key, got)
+			}
+		}
+		wantUA := eero.DefaultUserAgent + " eero-go/" + eero.Version
+		if got := r.Header.Values("User-Agent"); len(got) != 1 || got[0] != wantUA {
+			t.Errorf("Expected User-Agent to remain %q, got %q", wantUA, got)
+		}
+		if got := r.Header.Values("Content-Type"); len(got) != 1 {
+			t.Errorf("Expected exactly one Content-Type header, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "token_12345"}}`))
+	}))
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithAppHeaders())
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.Auth.Login(ctx, "test@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestNewClient_NoAppHeadersByDefault(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			t.Errorf("Expected no Origin header by default, got %q", origin)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL
+
+	if err := client.Auth.Verify(context.Background(), "123456"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestNewClient_Options(t *testing.T) {
+	t.Parallel()
+
+	custom := &http.Client{Timeout: 5 * time.Second}
+
+	client, err := eero.NewClient(
+		eero.WithBaseURL("https://example.com/2.2/"),
+		eero.WithUserAgent("my-tool/1.0"),
+		eero.WithHTTPClient(custom),
+		eero.WithTimeout(10*time.Second),
+	)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	if client.BaseURL != "https://example.com/2.2" {
+		t.Errorf("Expected trailing slash to be trimmed, got %q", client.BaseURL)
+	}
+	if client.UserAgent != "my-tool/1.0" {
+		t.Errorf("Expected custom user agent, got %q", client.UserAgent)
+	}
+	if client.HTTPClient.Jar == nil {
+		t.Error("Expected default cookie jar to be kept for a jar-less HTTP client")
+	}
+	if client.HTTPClient.CheckRedirect == nil {
+		t.Error("Expected default redirect policy to be kept for a custom HTTP client")
+	}
+	if client.HTTPClient.Timeout != 10*time.Second {
+		t.Errorf("Expected timeout 10s, got %s", client.HTTPClient.Timeout)
+	}
+	if custom.Timeout != 5*time.Second || custom.Jar != nil {
+		t.Error("Expected the caller's HTTP client to be left untouched")
+	}
+}
+
+func TestNewClient_TransportTuning(t *testing.T) {
+	t.Parallel()
+
+	shared := &http.Transport{MaxIdleConns: 50}
+	client, err := eero.NewClient(
+		eero.WithHTTPClient(&http.Client{Transport: shared}),
+		eero.WithMaxIdleConnsPerHost(64),
+		eero.WithIdleConnTimeout(2*time.Minute),
+		eero.WithTLSHandshakeTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	tr, ok := client.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", client.HTTPClient.Transport)
+	}
+	if tr.MaxIdleConnsPerHost != 64 || tr.MaxIdleConns != 64 {
+		t.Errorf("Expected 64 idle conns per host and overall, got %d/%d", tr.MaxIdleConnsPerHost, tr.MaxIdleConns)
+	}
+	if tr.IdleConnTimeout != 2*time.Minute || tr.TLSHandshakeTimeout != 5*time.Second {
+		t.Errorf("Unexpected timeouts: idle %s, TLS %s", tr.IdleConnTimeout, tr.TLSHandshakeTimeout)
+	}
+	if shared.MaxIdleConnsPerHost != 0 || shared.IdleConnTimeout != 0 {
+		t.Error("Expected the caller's transport to be left untouched")
+	}
+	if client.HTTPClient.CheckRedirect == nil {
+		t.Error("Expected the redirect policy to be kept")
+	}
+}
+
+func TestNewClient_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts []eero.Option
+	}{
+		{name: "BaseURL_NoScheme", opts: []eero.Option{eero.WithBaseURL("api-user.e2ro.com/2.2")}},
+		{name: "BaseURL_NoHost", opts: []eero.Option{eero.WithBaseURL("https:///2.2")}},
+		{name: "UserAgent_Empty", opts: []eero.Option{eero.WithUserAgent(" ")}},
+		{name: "UserAgentSuffix_LineBreak", opts: []eero.Option{eero.WithUserAgentSuffix("x\r\nX-Evil: 1")}},
+		{name: "HTTPClient_Nil", opts: []eero.Option{eero.WithHTTPClient(nil)}},
+		{name: "Timeout_Zero", opts: []eero.Option{eero.WithTimeout(0)}},
+		{name: "MaxIdleConnsPerHost_Zero", opts: []eero.Option{eero.WithMaxIdleConnsPerHost(0)}},
+		{name: "IdleConnTimeout_Negative", opts: []eero.Option{eero.WithIdleConnTimeout(-time.Second)}},
+		{name: "TLSHandshakeTimeout_Zero", opts: []eero.Option{eero.WithTLSHandshakeTimeout(0)}},
+		{
+			name: "TransportTuning_AfterWrap",
+			opts: []eero.Option{
+				eero.WithTransport(func(base http.RoundTripper) http.RoundTripper { return roundTripperFunc(base.RoundTrip) }),
+				eero.WithIdleConnTimeout(time.Minute),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := eero.NewClient(tc.opts...)
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if client != nil {
+				t.Error("Expected a nil client on error")
+			}
+		})
+	}
+}
+
+func TestNewClient_AggregatesOptionErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := eero.NewClient(eero.WithBaseURL("nope"), eero.WithTimeout(-1))
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "WithBaseURL") || !strings.Contains(msg, "WithTimeout") {
+		t.Errorf("Expected both option errors to be reported, got %q", msg)
+	}
+}
+
+func TestWithForceJSONContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		opts              []eero.Option
+		expectContentType string
+	}{
+		{
+			name:              "Default_NoHeaderOnEmptyBody",
+			expectContentType: "",
+		},
+		{
+			name:              "Forced_HeaderOnEmptyBody",
+			opts:              []eero.Option{eero.WithForceJSONContentType()},
+			expectContentType: "application/json",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/44444/reboot", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				if r.ContentLength != 0 {
+					t.Errorf("Expected empty body, got length %d", r.ContentLength)
+				}
+				if got := r.Header.Get("Content-Type"); got != tc.expectContentType {
+					t.Errorf("Expected Content-Type %q, got %q", tc.expectContentType, got)
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := eero.NewClient(tc.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			if err := client.Network.Reboot(ctx, "/2.2/networks/44444"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWithUserAgentSuffix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     []eero.Option
+		expectUA string
+	}{
+		{
+			name:     "Default_IncludesVersion",
+			expectUA: eero.DefaultUserAgent + " eero-go/" + eero.Version,
+		},
+		{
+			name:     "CustomUserAgent_KeepsSuffix",
+			opts:     []eero.Option{eero.WithUserAgent("my-tool/1.0")},
+			expectUA: "my-tool/1.0 eero-go/" + eero.Version,
+		},
+		{
+			name:     "Overridden",
+			opts:     []eero.Option{eero.WithUserAgentSuffix("homelab/2.0")},
+			expectUA: eero.DefaultUserAgent + " homelab/2.0",
+		},
+		{
+			name:     "Disabled",
+			opts:     []eero.Option{eero.WithUserAgentSuffix("")},
+			expectUA: eero.DefaultUserAgent,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("User-Agent"); got != tc.expectUA {
+					t.Errorf("Expected User-Agent %q, got %q", tc.expectUA, got)
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			}))
+			defer server.Close()
+
+			client, err := eero.NewClient(tc.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = server.URL
+
+			if err := client.Auth.Verify(context.Background(), "123456"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWithDebugDump(t *testing.T) {
+	t.Parallel()
+
+	const secret = "secret-token-abc"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "` + secret + `"}}`))
+	})
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home", "guest_network": {"name": "Guests", "password": "hunter22"}}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client, err := eero.NewClient(eero.WithDebugDump(&buf))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	client.BaseURL = server.URL
+	if _, err := client.Auth.Login(ctx, "test@example.com"); err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+	if _, err := client.Network.Get(ctx, "/2.2/networks/44444"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	dump := buf.String()
+	for _, want := range []string{"POST /login", "GET /2.2/networks/44444", `"user_token"`, `"name": "Home"`, `"guest_network"`, `"[REDACTED]"`} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("Expected dump to contain %s, got:\n%s", want, dump)
+		}
+	}
+	for _, leaked := range []string{secret, "hunter22"} {
+		if strings.Contains(dump, leaked) {
+			t.Errorf("Dump leaked %q:\n%s", leaked, dump)
+		}
+	}
+}
+
+func TestWithDebugDump_NilWriter(t *testing.T) {
+	t.Parallel()
+
+	if _, err := eero.NewClient(eero.WithDebugDump(nil)); err == nil {
+		t.Fatal("Expected error for nil writer, got nil")
+	}
+}
+
+func TestWithRequireContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		opts           []eero.Option
+		withDeadline   bool
+		wantNoDeadline bool
+		wantRequests   int32
+	}{
+		{
+			name:         "Default_DeadlineLessAllowed",
+			wantRequests: 1,
+		},
+		{
+			name:           "Required_DeadlineLessRejected",
+			opts:           []eero.Option{eero.WithRequireContextDeadline()},
+			wantNoDeadline: true,
+			wantRequests:   0,
+		},
+		{
+			name:         "Required_BoundedContextAllowed",
+			opts:         []eero.Option{eero.WithRequireContextDeadline()},
+			withDeadline: true,
+			wantRequests: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home"}}`))
+			}))
+			defer server.Close()
+
+			client, err := eero.NewClient(tc.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx := context.Background()
+			if tc.withDeadline {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, 2*time.Second)
+				defer cancel()
+			}
+
+			_, err = client.Network.Get(ctx, "/2.2/networks/44444")
+			if got := errors.Is(err, eero.ErrNoDeadline); got != tc.wantNoDeadline {
+				t.Fatalf("errors.Is(err, ErrNoDeadline) = %v, want %v (err: %v)", got, tc.wantNoDeadline, err)
+			}
+			if !tc.wantNoDeadline && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := atomic.LoadInt32(&requests); n != tc.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tc.wantRequests, n)
+			}
+		})
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	type entry struct {
+		method string
+		url    string
+		status int
+		dur    time.Duration
+		ctxVal any
+	}
+	type ctxKey struct{}
+
+	var mu sync.Mutex
+	var entries []entry
+	logger := func(ctx context.Context, method, url string, status int, dur time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		entries = append(entries, entry{method, url, status, dur, ctx.Value(ctxKey{})})
+	}
+
+	var attempts int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/44444", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"meta": {"code": 503}, "data": {}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home"}}`))
+	})
+	mux.HandleFunc("/2.2/networks/44444/devices", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"meta": {"code": 404}, "data": {}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithLogger(logger), eero.WithRetry(2, time.Millisecond))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+	if err := client.SetSessionCookie("secret-token-abc"); err != nil {
+		t.Fatalf("SetSessionCookie: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), ctxKey{}, "trace-1"), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.Network.Get(ctx, "/2.2/networks/44444?s=secret-token-abc&page=2"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	_, _ = client.Device.List(ctx, "/2.2/networks/44444")
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(entries) != 2 {
+		t.Fatalf("Expected one log entry per call (retries folded in), got %d: %+v", len(entries), entries)
+	}
+
+	first := entries[0]
+	if first.method != http.MethodGet || first.status != http.StatusOK || first.dur <= 0 || first.ctxVal != "trace-1" {
+		t.Errorf("Unexpected first entry: %+v", first)
+	}
+	if strings.Contains(first.url, "secret-token-abc") {
+		t.Errorf("Logged URL leaked the session token: %s", first.url)
+	}
+	if !strings.Contains(first.url, "/2.2/networks/44444") || !strings.Contains(first.url, "page=2") {
+		t.Errorf("Logged URL lost non-sensitive parts: %s", first.url)
+	}
+
+	if second := entries[1]; second.status != http.StatusNotFound || !strings.HasSuffix(second.url, "/devices") {
+		t.Errorf("Unexpected second entry: %+v", second)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestWithTransport(t *testing.T) {
+	t.Parallel()
+
+	var sawCookie atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("s"); err == nil {
+			sawCookie.Store(c.Value)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home"}}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var bases []http.RoundTripper
+	middleware := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(base http.RoundTripper) http.RoundTripper {
+			bases = append(bases, base)
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return base.RoundTrip(r)
+			})
+		}
+	}
+
+	client, err := eero.NewClient(
+		eero.WithTimeout(5*time.Second),
+		eero.WithTransport(middleware("inner")),
+		eero.WithTransport(middleware("outer")),
+	)
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+
+	if _, ok := bases[0].(*http.Transport); !ok {
+		t.Errorf("Expected the default *http.Transport as the innermost base, got %T", bases[0])
+	}
+	if client.HTTPClient.Jar == nil || client.HTTPClient.CheckRedirect == nil || client.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("Expected jar, redirect policy and timeout to be preserved, got %+v", client.HTTPClient)
+	}
+
+	// SetSessionCookie scopes the cookie to BaseURL, so set it after pointing
+	// BaseURL at the test server.
+	if err := client.SetSessionCookie("token_12345"); err != nil {
+		t.Fatalf("SetSessionCookie: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.Network.Get(ctx, "/2.2/networks/44444"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if fmt.Sprint(order) != "[outer inner]" {
+		t.Errorf("Expected middleware order [outer inner], got %v", order)
+	}
+	if got, _ := sawCookie.Load().(string); got != "token_12345" {
+		t.Errorf("Expected session cookie to reach the server through the middleware, got %q", got)
+	}
+}
+
+func TestWithTransport_Nil(t *testing.T) {
+	t.Parallel()
+
+	if _, err := eero.NewClient(eero.WithTransport(nil)); err == nil {
+		t.Fatal("Expected error for nil wrap, got nil")
+	}
+	if _, err := eero.NewClient(eero.WithTransport(func(http.RoundTripper) http.RoundTripper { return nil })); err == nil {
+		t.Fatal("Expected error for nil RoundTripper, got nil")
+	}
+}
+
+// closeCountingTransport records calls to CloseIdleConnections.
+type closeCountingTransport struct {
+	http.RoundTripper
+	closed atomic.Int32
+}
+
+func (t *closeCountingTransport) CloseIdleConnections() { t.closed.Add(1) }
+
+func TestClient_CloseIdleConnections(t *testing.T) {
+	t.Parallel()
+
+	counting := &closeCountingTransport{RoundTripper: http.DefaultTransport}
+	client, err := eero.NewClient(eero.WithHTTPClient(&http.Client{Transport: counting}))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	client.CloseIdleConnections()
+	if n := counting.closed.Load(); n != 1 {
+		t.Errorf("Expected CloseIdleConnections to be forwarded once, got %d", n)
+	}
+
+	// A RoundTripper without CloseIdleConnections is left alone.
+	plain, err := eero.NewClient(eero.WithHTTPClient(&http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	plain.CloseIdleConnections()
+
+	// The default transport works too.
+	def, _ := eero.NewClient()
+	def.CloseIdleConnections()
+}
+
+func TestWithRequestID(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var sent, logged []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.Header.Get(eero.RequestIDHeader))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, err := eero.NewClient(eero.WithLogger(func(ctx context.Context, method, url string, status int, dur time.Duration) {
+		id, _ := eero.RequestIDFromContext(ctx)
+		mu.Lock()
+		logged = append(logged, id)
+		mu.Unlock()
+	}))
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := client.Network.Get(eero.WithRequestID(ctx, "support-ticket-42"), "/2.2/networks/44444"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.Network.Get(ctx, "/2.2/networks/44444"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(sent) != 2 || len(logged) != 2 {
+		t.Fatalf("Expected 2 requests and 2 log entries, got %v and %v", sent, logged)
+	}
+	if sent[0] != "support-ticket-42" || logged[0] != "support-ticket-42" {
+		t.Errorf("Expected the caller's ID to be sent and logged, got %q / %q", sent[0], logged[0])
+	}
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(sent[1]) {
+		t.Errorf("Expected a generated UUID, got %q", sent[1])
+	}
+	if logged[1] != sent[1] {
+		t.Errorf("Expected the logger to see the generated ID %q, got %q", sent[1], logged[1])
+	}
+}
@@ -0,0 +1,53 @@
+package eero
+
+import "strings"
+
+// PremiumTier is an eero subscription tier as reported in the "tier" field of
+// PremiumDetails and NetworkPremiumDetails. Tiers are ordered so callers can
+// gate features with AtLeast instead of comparing raw strings.
+type PremiumTier string
+
+// Known subscription tiers, from least to most capable.
+const (
+	TierNone       PremiumTier = ""
+	TierSecure     PremiumTier = "secure"
+	TierSecurePlus PremiumTier = "secure_plus"
+	TierPlus       PremiumTier = "plus"
+)
+
+// tierRanks orders the known tiers. eero Plus superseded Secure+, so both
+// share a rank.
+var tierRanks = map[PremiumTier]int{
+	TierNone:       0,
+	TierSecure:     1,
+	TierSecurePlus: 2,
+	TierPlus:       2,
+}
+
+// normalize lowercases the tier and trims surrounding whitespace so that
+// variations in API casing compare equal.
+func (t PremiumTier) normalize() PremiumTier {
+	return PremiumTier(strings.ToLower(strings.TrimSpace(string(t))))
+}
+
+// Known reports whether t is one of the tiers this package understands.
+func (t PremiumTier) Known() bool {
+	_, ok := tierRanks[t.normalize()]
+	return ok
+}
+
+// rank returns the ordering position of t. Unknown tiers rank alongside
+// TierNone so that they never unlock gated features.
+func (t PremiumTier) rank() int {
+	return tierRanks[t.normalize()]
+}
+
+// AtLeast reports whether t is the same as or more capable than min.
+// An unknown t only satisfies TierNone, and an unknown min is never
+// satisfied, so unrecognized values fail closed.
+func (t PremiumTier) AtLeast(min PremiumTier) bool {
+	if !min.Known() {
+		return false
+	}
+	return t.rank() >= min.rank()
+}
@@ -0,0 +1,60 @@
+package eero_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestPremiumTier_AtLeast(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tier eero.PremiumTier
+		min  eero.PremiumTier
+		want bool
+	}{
+		{name: "Plus_AtLeastSecure", tier: eero.TierPlus, min: eero.TierSecure, want: true},
+		{name: "Plus_AtLeastPlus", tier: eero.TierPlus, min: eero.TierPlus, want: true},
+		{name: "SecurePlus_EquivalentToPlus", tier: eero.TierSecurePlus, min: eero.TierPlus, want: true},
+		{name: "Secure_NotAtLeastPlus", tier: eero.TierSecure, min: eero.TierPlus, want: false},
+		{name: "None_NotAtLeastSecure", tier: eero.TierNone, min: eero.TierSecure, want: false},
+		{name: "None_AtLeastNone", tier: eero.TierNone, min: eero.TierNone, want: true},
+		{name: "CaseInsensitive", tier: "PLUS", min: eero.TierSecure, want: true},
+		{name: "Unknown_OnlySatisfiesNone", tier: "platinum", min: eero.TierSecure, want: false},
+		{name: "Unknown_SatisfiesNone", tier: "platinum", min: eero.TierNone, want: true},
+		{name: "UnknownMin_NeverSatisfied", tier: eero.TierPlus, min: "platinum", want: false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.tier.AtLeast(tc.min); got != tc.want {
+				t.Errorf("%q.AtLeast(%q) = %v, want %v", tc.tier, tc.min, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPremiumTier_Known(t *testing.T) {
+	t.Parallel()
+
+	if !eero.TierSecure.Known() {
+		t.Error("Expected TierSecure to be known")
+	}
+	if eero.PremiumTier("platinum").Known() {
+		t.Error("Expected unrecognized tier to be unknown")
+	}
+
+	var details eero.NetworkPremiumDetails
+	if err := json.Unmarshal([]byte(`{"tier": "plus"}`), &details); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !details.Tier.AtLeast(eero.TierSecure) {
+		t.Errorf("Expected decoded tier %q to be at least secure", details.Tier)
+	}
+}
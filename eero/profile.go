@@ -2,37 +2,62 @@ package eero
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
+// ProfileAPI is the method set of ProfileService and the type of
+// Client.Profile. See NetworkAPI for its use with fakes.
+type ProfileAPI interface {
+	List(ctx context.Context, networkURL string) ([]Profile, error)
+	Create(ctx context.Context, networkURL, name string, deviceURLs []string) (*Profile, error)
+	EnsureProfile(ctx context.Context, networkURL, name string) (*Profile, error)
+	Delete(ctx context.Context, profileURL string, opts ...DeleteOption) error
+	AssignDevice(ctx context.Context, profileURL, deviceURL string) error
+	RemoveDevice(ctx context.Context, profileURL, deviceURL string) error
+	SetBedtime(ctx context.Context, profileURL string, schedule Schedule) error
+	SetSchedules(ctx context.Context, profileURL string, schedules []Schedule) error
+	Pause(ctx context.Context, profileURL string) error
+	Unpause(ctx context.Context, profileURL string) error
+}
+
 // ProfileService manages user profiles (e.g., family members) on an eero
 // network, including pausing and unpausing internet access.
 type ProfileService struct {
 	client *Client
 }
 
+var _ ProfileAPI = (*ProfileService)(nil)
+
 // --- Response types ---
 
 // Profile represents a user profile on the eero network.
 type Profile struct {
-	URL              string    `json:"url"`
-	Name             string    `json:"name"`
-	Paused           bool      `json:"paused"`
-	DeviceCount      int       `json:"device_count"`
-	Devices          []Device  `json:"devices"`
-	BlockApps        bool      `json:"block_apps"`
-	SafeSearchActive bool      `json:"safe_search_enabled"`
-	Bedtime          *Schedule `json:"bedtime"`
+	URL              string     `json:"url"`
+	Name             string     `json:"name"`
+	Paused           bool       `json:"paused"`
+	DeviceCount      int        `json:"device_count"`
+	Devices          []Device   `json:"devices"`
+	BlockApps        bool       `json:"block_apps"`
+	SafeSearchActive bool       `json:"safe_search_enabled"`
+	Bedtime          *Schedule  `json:"bedtime"`
+	Schedules        []Schedule `json:"schedules"`
 }
 
 // ProfileDevice is a lightweight device reference within a profile.
 // Unused directly; now mapped using the detailed complete Device models.
 
 // Schedule represents a scheduled action (e.g., bedtime) on a profile.
+// Time is a 24-hour "HH:MM" local time. Days lists the lowercase weekdays
+// ("monday" … "sunday") the schedule applies to; empty means every day.
 type Schedule struct {
-	Enabled bool   `json:"enabled"`
-	Time    string `json:"time"`
+	Name    string   `json:"name,omitempty"`
+	Enabled bool     `json:"enabled"`
+	Time    string   `json:"time"`
+	Days    []string `json:"days,omitempty"`
 }
 
 // pauseRequest is the body for pausing/unpausing a profile.
@@ -40,6 +65,49 @@ type pauseRequest struct {
 	Paused bool `json:"paused"`
 }
 
+// createProfileRequest is the body for creating a profile.
+type createProfileRequest struct {
+	Name    string   `json:"name"`
+	Devices []string `json:"devices"`
+}
+
+// bedtimeRequest is the body for setting a profile's bedtime.
+type bedtimeRequest struct {
+	Bedtime Schedule `json:"bedtime"`
+}
+
+// schedulesRequest is the body for replacing a profile's scheduled pauses.
+type schedulesRequest struct {
+	Schedules []Schedule `json:"schedules"`
+}
+
+// weekdays is the set of day names accepted in Schedule.Days.
+var weekdays = map[string]bool{
+	"monday": true, "tuesday": true, "wednesday": true, "thursday": true,
+	"friday": true, "saturday": true, "sunday": true,
+}
+
+// deviceProfileRequest is the body for moving a device between profiles.
+// A nil Profile encodes as JSON null, which unassigns the device.
+type deviceProfileRequest struct {
+	Profile *string `json:"profile"`
+}
+
+// DeleteOption customizes a ProfileService.Delete call.
+type DeleteOption func(*deleteOptions)
+
+type deleteOptions struct {
+	idempotent bool
+}
+
+// Idempotent makes Delete treat a 404 (the profile is already gone) as
+// success, so cleanup code can safely run more than once.
+func Idempotent() DeleteOption {
+	return func(o *deleteOptions) {
+		o.idempotent = true
+	}
+}
+
 // --- Methods ---
 
 // List returns all profiles on the specified network.
@@ -60,6 +128,187 @@ func (s *ProfileService) List(ctx context.Context, networkURL string) ([]Profile
 	return resp.Data, nil
 }
 
+// Create adds a new profile to the specified network, optionally assigning
+// devices to it, and returns the created profile including its assigned URL.
+// An empty or whitespace-only name is rejected with ErrInvalidArgument before
+// any request is sent.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). Each entry in deviceURLs should be
+// a device URL as returned by DeviceService.List.
+func (s *ProfileService) Create(ctx context.Context, networkURL, name string, deviceURLs []string) (*Profile, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("profile: create: %w: name is empty", ErrInvalidArgument)
+	}
+
+	body := createProfileRequest{Name: name, Devices: deviceURLs}
+	if body.Devices == nil {
+		body.Devices = []string{}
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "profile", http.MethodPost, networkURL+"/profiles", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[Profile]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("profile: create: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// EnsureProfile returns the network's profile named name, creating it (with
+// no devices) if none exists. Names match case-insensitively, ignoring
+// surrounding whitespace, so provisioning scripts can call it repeatedly.
+// An empty name is rejected with ErrInvalidArgument before any request is
+// sent.
+//
+// The lookup and creation are two requests, so two concurrent callers may
+// both create the profile.
+func (s *ProfileService) EnsureProfile(ctx context.Context, networkURL, name string) (*Profile, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("profile: ensure: %w: name is empty", ErrInvalidArgument)
+	}
+
+	profiles, err := s.List(ctx, networkURL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range profiles {
+		if strings.EqualFold(strings.TrimSpace(profiles[i].Name), name) {
+			return &profiles[i], nil
+		}
+	}
+
+	return s.Create(ctx, networkURL, name, nil)
+}
+
+// Delete removes the given profile. Devices that were assigned to it are not
+// removed from the network; the server moves them back to "Unassigned".
+//
+// By default a missing profile surfaces as a 404 *APIError. Pass Idempotent()
+// to treat that case as success.
+//
+// The profileURL parameter should be the exact relative URL from the profile
+// response (e.g., "/2.2/networks/12345/profiles/67890").
+func (s *ProfileService) Delete(ctx context.Context, profileURL string, opts ...DeleteOption) error {
+	var o deleteOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	req, err := s.client.newRequestFromURL(ctx, "profile", http.MethodDelete, profileURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		var apiErr *APIError
+		if o.idempotent && errors.As(err, &apiErr) && (apiErr.HTTPStatusCode == http.StatusNotFound || apiErr.Code == http.StatusNotFound) {
+			return nil
+		}
+		return fmt.Errorf("profile: delete: %w", err)
+	}
+
+	return nil
+}
+
+// AssignDevice moves a device into the given profile by updating the
+// device's profile reference. Devices belong to at most one profile, so this
+// also removes it from any previous profile. Assigning a device that is
+// already in the profile is a no-op and returns nil.
+//
+// The profileURL and deviceURL parameters should be the exact relative URLs
+// from the API (e.g., "/2.2/networks/12345/profiles/67890" and
+// "/2.2/networks/12345/devices/abcdef").
+func (s *ProfileService) AssignDevice(ctx context.Context, profileURL, deviceURL string) error {
+	device, err := s.client.Device.Get(ctx, deviceURL)
+	if err != nil {
+		return err
+	}
+	if device.Profile.URL == profileURL {
+		return nil
+	}
+	return s.update(ctx, deviceURL, "assign device", deviceProfileRequest{Profile: &profileURL})
+}
+
+// RemoveDevice takes a device out of the given profile, returning it to
+// "Unassigned". If the device is not currently in that profile, nothing is
+// changed and nil is returned.
+//
+// The profileURL and deviceURL parameters should be the exact relative URLs
+// from the API.
+func (s *ProfileService) RemoveDevice(ctx context.Context, profileURL, deviceURL string) error {
+	device, err := s.client.Device.Get(ctx, deviceURL)
+	if err != nil {
+		return err
+	}
+	if device.Profile.URL != profileURL {
+		return nil
+	}
+	return s.update(ctx, deviceURL, "remove device", deviceProfileRequest{})
+}
+
+// SetBedtime sets the profile's bedtime schedule. The schedule is validated
+// first; a malformed Time or unknown day returns ErrInvalidArgument without
+// contacting the API.
+//
+// The profileURL parameter should be the exact relative URL from the profile
+// response (e.g., "/2.2/networks/12345/profiles/67890").
+func (s *ProfileService) SetBedtime(ctx context.Context, profileURL string, schedule Schedule) error {
+	if err := validateSchedule(schedule); err != nil {
+		return fmt.Errorf("profile: set bedtime: %w", err)
+	}
+	return s.update(ctx, profileURL, "set bedtime", bedtimeRequest{Bedtime: schedule})
+}
+
+// SetSchedules replaces all scheduled pauses on the profile with schedules.
+// eero supports several per profile (e.g. a school-night and a weekend
+// bedtime); pass an empty slice to remove them all. Every schedule is
+// validated as in SetBedtime before anything is sent.
+func (s *ProfileService) SetSchedules(ctx context.Context, profileURL string, schedules []Schedule) error {
+	for i, sched := range schedules {
+		if err := validateSchedule(sched); err != nil {
+			return fmt.Errorf("profile: set schedules: schedule %d: %w", i, err)
+		}
+	}
+	if schedules == nil {
+		schedules = []Schedule{}
+	}
+	return s.update(ctx, profileURL, "set schedules", schedulesRequest{Schedules: schedules})
+}
+
+// validateSchedule checks that a schedule's time is "HH:MM" and its days are
+// known weekday names.
+func validateSchedule(sched Schedule) error {
+	if _, err := time.Parse("15:04", sched.Time); err != nil || len(sched.Time) != len("15:04") {
+		return fmt.Errorf("%w: time %q is not HH:MM", ErrInvalidArgument, sched.Time)
+	}
+	for _, day := range sched.Days {
+		if !weekdays[day] {
+			return fmt.Errorf("%w: unknown day %q", ErrInvalidArgument, day)
+		}
+	}
+	return nil
+}
+
+// update PUTs body to resourceURL, wrapping failures as "profile: <op>".
+func (s *ProfileService) update(ctx context.Context, resourceURL, op string, body any) error {
+	req, err := s.client.newRequestFromURL(ctx, "profile", http.MethodPut, resourceURL, body)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("profile: %s: %w", op, err)
+	}
+
+	return nil
+}
+
 // Pause pauses internet access for the given profile.
 //
 // The profileURL parameter should be the exact relative URL from the profile
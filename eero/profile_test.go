@@ -2,9 +2,11 @@ package eero_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -186,3 +188,448 @@ func TestProfileService_Unpause(t *testing.T) {
 		t.Fatalf("Expected no error unpausing profile, got: %v", err)
 	}
 }
+
+func TestProfileService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		profileName string
+		deviceURLs  []string
+		expectBody  string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:        "Success_WithDevices",
+			profileName: "Kids",
+			deviceURLs:  []string{"/2.2/networks/55555/devices/aaa", "/2.2/networks/55555/devices/bbb"},
+			expectBody:  `{"name":"Kids","devices":["/2.2/networks/55555/devices/aaa","/2.2/networks/55555/devices/bbb"]}`,
+		},
+		{
+			name:        "Success_NoDevices",
+			profileName: "Guests",
+			expectBody:  `{"name":"Guests","devices":[]}`,
+		},
+		{
+			name:        "Failure_EmptyName",
+			profileName: " ",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/55555/profiles", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{
+					"meta": {"code": 200},
+					"data": {
+						"url": "/2.2/networks/55555/profiles/999",
+						"name": "` + tc.profileName + `",
+						"paused": false,
+						"device_count": 1,
+						"devices": [
+							{"url": "/2.2/networks/55555/devices/aaa", "mac": "AA:BB:CC:DD:EE:01", "hostname": "tablet"}
+						]
+					}
+				}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			profile, err := client.Profile.Create(ctx, "/2.2/networks/55555", tc.profileName, tc.deviceURLs)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&hits); n != 0 {
+					t.Errorf("Expected no request to be sent, got %d", n)
+				}
+				return
+			}
+			if profile.URL != "/2.2/networks/55555/profiles/999" {
+				t.Errorf("Expected assigned URL, got %s", profile.URL)
+			}
+			if len(profile.Devices) != 1 || profile.Devices[0].Hostname == nil || *profile.Devices[0].Hostname != "tablet" {
+				t.Errorf("Expected nested device to decode, got %+v", profile.Devices)
+			}
+		})
+	}
+}
+
+func TestProfileService_EnsureProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		profileName string
+		wantURL     string
+		wantPosts   int32
+		wantInvalid bool
+	}{
+		{
+			name:        "Success_ExistingCaseInsensitive",
+			profileName: "  kids ",
+			wantURL:     "/2.2/networks/55555/profiles/1",
+		},
+		{
+			name:        "Success_CreatesMissing",
+			profileName: "Guests",
+			wantURL:     "/2.2/networks/55555/profiles/999",
+			wantPosts:   1,
+		},
+		{
+			name:        "Failure_EmptyName",
+			profileName: "",
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gets, posts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/55555/profiles", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					atomic.AddInt32(&gets, 1)
+					_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": [
+						{"url": "/2.2/networks/55555/profiles/1", "name": "Kids"},
+						{"url": "/2.2/networks/55555/profiles/2", "name": "Work"}
+					]}`))
+				case http.MethodPost:
+					atomic.AddInt32(&posts, 1)
+					body, _ := io.ReadAll(r.Body)
+					if want := `{"name":"Guests","devices":[]}`; string(body) != want {
+						t.Errorf("Expected body %s, got %s", want, string(body))
+					}
+					_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "/2.2/networks/55555/profiles/999", "name": "Guests"}}`))
+				}
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			profile, err := client.Profile.EnsureProfile(context.Background(), "/2.2/networks/55555", tc.profileName)
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Fatalf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if gets+posts != 0 {
+					t.Errorf("Expected no requests, got %d", gets+posts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EnsureProfile() error = %v", err)
+			}
+			if profile.URL != tc.wantURL {
+				t.Errorf("Expected profile %s, got %s", tc.wantURL, profile.URL)
+			}
+			if got := atomic.LoadInt32(&posts); got != tc.wantPosts {
+				t.Errorf("Expected %d creates, got %d", tc.wantPosts, got)
+			}
+		})
+	}
+}
+
+func TestProfileService_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		opts         []eero.DeleteOption
+		mockStatus   int
+		mockResponse string
+		wantErr      bool
+	}{
+		{
+			name:         "Success_Deleted",
+			mockStatus:   http.StatusOK,
+			mockResponse: `{"meta": {"code": 200}, "data": {}}`,
+		},
+		{
+			name:         "Failure_NotFoundByDefault",
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "error.profile.notFound"}, "data": {}}`,
+			wantErr:      true,
+		},
+		{
+			name:         "Success_NotFoundIdempotent",
+			opts:         []eero.DeleteOption{eero.Idempotent()},
+			mockStatus:   http.StatusNotFound,
+			mockResponse: `{"meta": {"code": 404, "error": "error.profile.notFound"}, "data": {}}`,
+		},
+		{
+			name:         "Failure_ServerErrorIdempotent",
+			opts:         []eero.DeleteOption{eero.Idempotent()},
+			mockStatus:   http.StatusInternalServerError,
+			mockResponse: `{"meta": {"code": 500, "error": "boom"}, "data": {}}`,
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profileURL := "/2.2/networks/55555/profiles/111"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(profileURL, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("Expected DELETE, got %s", r.Method)
+				}
+				w.WriteHeader(tc.mockStatus)
+				_, _ = w.Write([]byte(tc.mockResponse))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := client.Profile.Delete(ctx, profileURL, tc.opts...)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != tc.mockStatus {
+					t.Errorf("Expected *eero.APIError with status %d, got %v", tc.mockStatus, err)
+				}
+			}
+		})
+	}
+}
+
+func TestProfileService_AssignAndRemoveDevice(t *testing.T) {
+	t.Parallel()
+
+	const (
+		profileURL = "/2.2/networks/55555/profiles/111"
+		otherURL   = "/2.2/networks/55555/profiles/222"
+		deviceURL  = "/2.2/networks/55555/devices/abcdef"
+	)
+
+	tests := []struct {
+		name           string
+		remove         bool
+		currentProfile string
+		expectBody     string
+		expectPuts     int32
+	}{
+		{
+			name:           "Assign_FromOtherProfile",
+			currentProfile: otherURL,
+			expectBody:     `{"profile":"` + profileURL + `"}`,
+			expectPuts:     1,
+		},
+		{
+			name:           "Assign_AlreadyInProfile",
+			currentProfile: profileURL,
+			expectPuts:     0,
+		},
+		{
+			name:           "Remove_FromProfile",
+			remove:         true,
+			currentProfile: profileURL,
+			expectBody:     `{"profile":null}`,
+			expectPuts:     1,
+		},
+		{
+			name:           "Remove_NotInProfile",
+			remove:         true,
+			currentProfile: otherURL,
+			expectPuts:     0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var puts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc(deviceURL, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "` + deviceURL + `", "profile": {"url": "` + tc.currentProfile + `", "name": "Current"}}}`))
+				case http.MethodPut:
+					atomic.AddInt32(&puts, 1)
+					body, _ := io.ReadAll(r.Body)
+					if string(body) != tc.expectBody {
+						t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+					}
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+				default:
+					t.Errorf("Unexpected method %s", r.Method)
+				}
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			var err error
+			if tc.remove {
+				err = client.Profile.RemoveDevice(ctx, profileURL, deviceURL)
+			} else {
+				err = client.Profile.AssignDevice(ctx, profileURL, deviceURL)
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := atomic.LoadInt32(&puts); n != tc.expectPuts {
+				t.Errorf("Expected %d PUTs, got %d", tc.expectPuts, n)
+			}
+		})
+	}
+}
+
+func TestProfileService_SetBedtime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, p eero.ProfileAPI, profileURL string) error
+		expectBody  string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name: "Success_Bedtime",
+			call: func(ctx context.Context, p eero.ProfileAPI, profileURL string) error {
+				return p.SetBedtime(ctx, profileURL, eero.Schedule{Enabled: true, Time: "21:30"})
+			},
+			expectBody: `{"bedtime":{"enabled":true,"time":"21:30"}}`,
+		},
+		{
+			name: "Success_MultipleSchedules",
+			call: func(ctx context.Context, p eero.ProfileAPI, profileURL string) error {
+				return p.SetSchedules(ctx, profileURL, []eero.Schedule{
+					{Name: "School nights", Enabled: true, Time: "21:00", Days: []string{"sunday", "monday", "tuesday", "wednesday", "thursday"}},
+					{Name: "Weekend", Enabled: false, Time: "23:00", Days: []string{"friday", "saturday"}},
+				})
+			},
+			expectBody: `{"schedules":[` +
+				`{"name":"School nights","enabled":true,"time":"21:00","days":["sunday","monday","tuesday","wednesday","thursday"]},` +
+				`{"name":"Weekend","enabled":false,"time":"23:00","days":["friday","saturday"]}]}`,
+		},
+		{
+			name: "Success_ClearSchedules",
+			call: func(ctx context.Context, p eero.ProfileAPI, profileURL string) error {
+				return p.SetSchedules(ctx, profileURL, nil)
+			},
+			expectBody: `{"schedules":[]}`,
+		},
+		{
+			name: "Failure_BadTime",
+			call: func(ctx context.Context, p eero.ProfileAPI, profileURL string) error {
+				return p.SetBedtime(ctx, profileURL, eero.Schedule{Enabled: true, Time: "9pm"})
+			},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name: "Failure_UnknownDay",
+			call: func(ctx context.Context, p eero.ProfileAPI, profileURL string) error {
+				return p.SetSchedules(ctx, profileURL, []eero.Schedule{{Enabled: true, Time: "21:00", Days: []string{"Funday"}}})
+			},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profileURL := "/2.2/networks/55555/profiles/111"
+			var hits int32
+
+			mux := http.NewServeMux()
+			mux.HandleFunc(profileURL, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+				if r.Method != http.MethodPut {
+					t.Errorf("Expected PUT, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err := tc.call(ctx, client.Profile, profileURL)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&hits); n != 0 {
+					t.Errorf("Expected no request to be sent, got %d", n)
+				}
+			}
+		})
+	}
+}
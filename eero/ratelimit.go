@@ -0,0 +1,95 @@
+package eero
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// errRateLimitWait is returned when the request context would expire before
+// a rate limit token becomes available. It wraps context.DeadlineExceeded so
+// callers can treat it like any other deadline failure.
+var errRateLimitWait = fmt.Errorf("rate limit wait would exceed context deadline: %w", context.DeadlineExceeded)
+
+// WithRateLimit throttles outbound requests to rps requests per second on
+// average, allowing bursts of up to burst requests. The limiter is shared by
+// every service on the client and is safe for concurrent use. Each attempt,
+// including retries, consumes a token.
+//
+// A request blocks until a token is available. If its context is cancelled
+// first, or its deadline would pass before the token is due, the request
+// fails without being sent.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) error {
+		if rps <= 0 || math.IsInf(rps, 0) || math.IsNaN(rps) {
+			return fmt.Errorf("WithRateLimit: rps must be a positive finite number, got %v", rps)
+		}
+		if burst < 1 {
+			return fmt.Errorf("WithRateLimit: burst must be at least 1, got %d", burst)
+		}
+		c.limiter = newTokenBucket(rps, burst)
+		return nil
+	}
+}
+
+// tokenBucket is a token-bucket rate limiter. Tokens accrue at rate per
+// second up to burst; a waiter may drive the balance negative, which queues
+// later waiters behind it in FIFO order.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.advance(now)
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if deadline, ok := ctx.Deadline(); ok && now.Add(delay).After(deadline) {
+		b.tokens++
+		b.mu.Unlock()
+		return errRateLimitWait
+	}
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		// Give the unused token back so later waiters are not penalized.
+		b.mu.Lock()
+		b.advance(time.Now())
+		b.tokens = math.Min(b.tokens+1, b.burst)
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// advance accrues tokens for the time elapsed since the last update. The
+// caller must hold b.mu.
+func (b *tokenBucket) advance(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.tokens+elapsed.Seconds()*b.rate, b.burst)
+		b.last = now
+	}
+}
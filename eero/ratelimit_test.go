@@ -0,0 +1,113 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestWithRateLimit_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rps     float64
+		burst   int
+		wantErr bool
+	}{
+		{name: "Success_Valid", rps: 5, burst: 1},
+		{name: "Failure_ZeroRate", rps: 0, burst: 1, wantErr: true},
+		{name: "Failure_NegativeRate", rps: -1, burst: 1, wantErr: true},
+		{name: "Failure_ZeroBurst", rps: 5, burst: 0, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := eero.NewClient(eero.WithRateLimit(tc.rps, tc.burst))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithRateLimit_Throttles(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	// 20 rps with a burst of 2: six concurrent requests need four extra
+	// tokens, so at least ~200ms must pass.
+	client, err := eero.NewClient(eero.WithRateLimit(20, 2))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.BaseURL = server.URL
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Account.Get(context.Background()); err != nil {
+				t.Errorf("Account.Get() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("Expected requests to be throttled to ~200ms, took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&hits); got != 6 {
+		t.Errorf("Expected 6 requests to reach the server, got %d", got)
+	}
+}
+
+func TestWithRateLimit_RespectsContext(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient(eero.WithRateLimit(0.1, 1))
+	client.BaseURL = server.URL
+
+	if _, err := client.Account.Get(context.Background()); err != nil {
+		t.Fatalf("first Account.Get() error = %v", err)
+	}
+
+	// The next token is ten seconds away; the deadline must fail fast.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Account.Get(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the limiter to give up promptly, took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("Expected only the first request to reach the server, got %d", got)
+	}
+}
@@ -0,0 +1,249 @@
+package eero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// WithRecorder saves every response the client receives to dir as a JSON
+// fixture, for later offline use with WithReplayer. Each exchange is written
+// to its own file, named after the request method, path and query (e.g.
+// "GET_2.2_networks_12345.json"); a repeated request overwrites its fixture,
+// so the last response wins. dir is created if needed.
+//
+// A fixture holds the request (method, URL, headers, body) and the response
+// (status, headers, body). Session credentials are redacted before anything
+// is written: the Cookie and X-User-Token request headers, Set-Cookie
+// values, and JSON body fields whose names mention a token, cookie or
+// password (such as the user_token returned by Login). Other personal data,
+// such as device names and MAC addresses, is kept as received; review
+// fixtures before committing them.
+//
+// WithRecorder wraps the transport configured so far, so it should follow
+// any WithTransport or transport tuning options.
+func WithRecorder(dir string) Option {
+	return func(c *Client) error {
+		if dir == "" {
+			return errors.New("WithRecorder: dir is empty")
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("WithRecorder: %w", err)
+		}
+		base := c.HTTPClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c.HTTPClient.Transport = &recordingTransport{base: base, dir: dir}
+		return nil
+	}
+}
+
+// WithReplayer serves every request from the fixtures in dir, written by
+// WithRecorder, instead of the network. Fixtures are matched by method, path
+// and query only, so BaseURL's host does not matter. A request without a
+// fixture fails with an error wrapping ErrFixtureNotFound.
+//
+// WithReplayer replaces the client's transport entirely; retries, rate
+// limiting and the response cache still apply on top of it.
+func WithReplayer(dir string) Option {
+	return func(c *Client) error {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return fmt.Errorf("WithReplayer: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("WithReplayer: %s is not a directory", dir)
+		}
+		c.HTTPClient.Transport = &replayTransport{dir: dir}
+		return nil
+	}
+}
+
+// fixture is the on-disk form of one recorded exchange.
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+}
+
+type fixtureRequest struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// BodyText holds a request body that is not valid JSON.
+	BodyText string `json:"body_text,omitempty"`
+}
+
+type fixtureResponse struct {
+	Status int             `json:"status"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// BodyText holds a response body that is not valid JSON.
+	BodyText string `json:"body_text,omitempty"`
+}
+
+// fixtureName returns the file name of the fixture for a request.
+func fixtureName(req *http.Request) string {
+	key := req.Method + "_" + strings.TrimPrefix(req.URL.EscapedPath(), "/")
+	if req.URL.RawQuery != "" {
+		key += "_" + req.URL.RawQuery
+	}
+	name := []byte(key)
+	for i, b := range name {
+		if !('a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9' || b == '.' || b == '-' || b == '_') {
+			name[i] = '_'
+		}
+	}
+	return string(name) + ".json"
+}
+
+// recordingTransport writes each exchange through base to a fixture file.
+type recordingTransport struct {
+	base http.RoundTripper
+	dir  string
+	mu   sync.Mutex // serializes fixture writes
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(rc)
+			_ = rc.Close()
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	// The client reads at most maxBodyBytes, so nothing past that is kept.
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	f := fixture{
+		Request: fixtureRequest{
+			Method: req.Method,
+			URL:    redactURL(req.URL),
+			Header: redactHeader(req.Header),
+		},
+		Response: fixtureResponse{
+			Status: resp.StatusCode,
+			Header: redactHeader(resp.Header),
+		},
+	}
+	// Redaction can change the body's length; replay recomputes it.
+	if f.Response.Header != nil {
+		f.Response.Header.Del("Content-Length")
+	}
+	f.Request.Body, f.Request.BodyText = redactBody(reqBody)
+	f.Response.Body, f.Response.BodyText = redactBody(respBody)
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("eero: recording fixture: %w", err)
+	}
+	t.mu.Lock()
+	err = os.WriteFile(filepath.Join(t.dir, fixtureName(req)), append(data, '\n'), 0o600)
+	t.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("eero: recording fixture: %w", err)
+	}
+	return resp, nil
+}
+
+// replayTransport answers requests from fixture files.
+type replayTransport struct {
+	dir string
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	data, err := os.ReadFile(filepath.Join(t.dir, fixtureName(req)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s", ErrFixtureNotFound, req.Method, redactURL(req.URL))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("eero: replaying fixture: %w", err)
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("eero: replaying fixture %s: %w", fixtureName(req), err)
+	}
+
+	body := []byte(f.Response.Body)
+	if len(body) == 0 {
+		body = []byte(f.Response.BodyText)
+	}
+	header := f.Response.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Response.Status, http.StatusText(f.Response.Status)),
+		StatusCode:    f.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// redactHeader returns a copy of h with session credentials replaced.
+func redactHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	out := h.Clone()
+	for _, key := range []string{"Cookie", userTokenHeader} {
+		if _, ok := out[key]; ok {
+			out[key] = []string{redactedValue}
+		}
+	}
+	if _, ok := out["Set-Cookie"]; ok {
+		cookies := (&http.Response{Header: h}).Cookies()
+		out["Set-Cookie"] = make([]string, 0, len(cookies))
+		for _, ck := range cookies {
+			ck.Value = redactedValue
+			out.Add("Set-Cookie", ck.String())
+		}
+	}
+	return out
+}
+
+// redactBody returns a JSON body with credential fields redacted, or a
+// non-JSON body as text.
+func redactBody(body []byte) (json.RawMessage, string) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, ""
+	}
+	var generic any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&generic); err != nil || dec.More() {
+		return nil, string(body)
+	}
+	out, err := json.Marshal(redact(generic))
+	if err != nil {
+		return nil, string(body)
+	}
+	return out, ""
+}
@@ -0,0 +1,112 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestWithRecorderAndReplayer_Validation(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "fixture.json")
+	if err := os.WriteFile(file, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		opt     eero.Option
+		wantErr bool
+	}{
+		{name: "Success_Recorder", opt: eero.WithRecorder(filepath.Join(t.TempDir(), "new", "dir"))},
+		{name: "Success_Replayer", opt: eero.WithReplayer(t.TempDir())},
+		{name: "Failure_RecorderEmptyDir", opt: eero.WithRecorder(""), wantErr: true},
+		{name: "Failure_ReplayerMissingDir", opt: eero.WithReplayer(filepath.Join(t.TempDir(), "absent")), wantErr: true},
+		{name: "Failure_ReplayerNotDir", opt: eero.WithReplayer(file), wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := eero.NewClient(tc.opt)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "s", Value: "issued_secret"})
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "issued_secret"}}`))
+	})
+	mux.HandleFunc("/2.2/networks/123", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home", "status": "online"}}`))
+	})
+	server := setupMockServer(mux.ServeHTTP)
+	defer server.Close()
+
+	recorder, err := eero.NewClient(eero.WithRecorder(dir))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	recorder.BaseURL = server.URL + "/2.2"
+	ctx := context.Background()
+
+	if _, err := recorder.Auth.Login(ctx, "user@example.com"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := recorder.Network.Get(ctx, "/2.2/networks/123"); err != nil {
+		t.Fatalf("Network.Get() error = %v", err)
+	}
+
+	for _, name := range []string{"POST_2.2_login.json", "GET_2.2_networks_123.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Expected fixture %s: %v", name, err)
+		}
+		if strings.Contains(string(data), "issued_secret") {
+			t.Errorf("Fixture %s leaks the session token:\n%s", name, data)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "GET_2.2_networks_123.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"Cookie": [`) {
+		t.Errorf("Expected the redacted Cookie header to be recorded:\n%s", data)
+	}
+
+	// Replay against a host that does not exist: nothing may reach the network.
+	replayer, err := eero.NewClient(eero.WithReplayer(dir), eero.WithBaseURL("https://replay.invalid/2.2"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	details, err := replayer.Network.Get(ctx, "/2.2/networks/123")
+	if err != nil {
+		t.Fatalf("Replayed Network.Get() error = %v", err)
+	}
+	if details.Name != "Home" || details.Status != "online" {
+		t.Errorf("Replayed Network.Get() = %q/%q, want \"Home\"/\"online\"", details.Name, details.Status)
+	}
+	if _, err := replayer.Auth.Login(ctx, "user@example.com"); err != nil {
+		t.Errorf("Replayed Login() error = %v", err)
+	}
+
+	_, err = replayer.Network.Get(ctx, "/2.2/networks/999")
+	if !errors.Is(err, eero.ErrFixtureNotFound) {
+		t.Errorf("Network.Get() without fixture error = %v, want ErrFixtureNotFound", err)
+	}
+}
@@ -0,0 +1,159 @@
+package eero
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// ReservationAPI is the method set of ReservationService and the type of
+// Client.Reservation. See NetworkAPI for its use with fakes.
+type ReservationAPI interface {
+	List(ctx context.Context, networkURL string) ([]Reservation, error)
+	Create(ctx context.Context, networkURL, mac, ip, description string) (*Reservation, error)
+	Delete(ctx context.Context, reservationURL string) error
+}
+
+// ReservationService manages DHCP reservations (static IP assignments) on an
+// eero network.
+type ReservationService struct {
+	client *Client
+}
+
+var _ ReservationAPI = (*ReservationService)(nil)
+
+// --- Response types ---
+
+// Reservation is a DHCP reservation pinning a device's MAC to an IPv4
+// address on the LAN.
+type Reservation struct {
+	URL         string `json:"url"`
+	MAC         string `json:"mac"`
+	IP          string `json:"ip"`
+	Description string `json:"description"`
+}
+
+// reservationRequest is the body for creating a reservation.
+type reservationRequest struct {
+	MAC         string `json:"mac"`
+	IP          string `json:"ip"`
+	Description string `json:"description"`
+}
+
+// --- Methods ---
+
+// List returns all DHCP reservations on the specified network.
+//
+// The networkURL parameter should be the exact relative URL from the account
+// response (e.g., "/2.2/networks/12345"). The "/reservations" suffix is
+// appended automatically.
+func (s *ReservationService) List(ctx context.Context, networkURL string) ([]Reservation, error) {
+	req, err := s.client.newRequestFromURL(ctx, "reservation", http.MethodGet, networkURL+"/reservations", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[[]Reservation]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("reservation: %w", err)
+	}
+
+	return resp.Data, nil
+}
+
+// Create reserves ip for the device with the given MAC and returns the new
+// reservation, whose URL can later be passed to Delete.
+//
+// Before sending, the MAC must parse and ip must be a valid IPv4 address. The
+// network is then fetched and, when it reports its LAN subnet
+// (NetworkDetails.Lease.DHCP), ip must be a host address inside it and not
+// the router's own address. Violations return ErrInvalidArgument. Networks
+// in bridge mode, where eero does not run DHCP, are refused with an error
+// wrapping ErrBridgeMode.
+func (s *ReservationService) Create(ctx context.Context, networkURL, mac, ip, description string) (*Reservation, error) {
+	if _, err := net.ParseMAC(mac); err != nil {
+		return nil, fmt.Errorf("reservation: create: %w: invalid MAC %q", ErrInvalidArgument, mac)
+	}
+	addr := net.ParseIP(ip).To4()
+	if addr == nil {
+		return nil, fmt.Errorf("reservation: create: %w: %q is not an IPv4 address", ErrInvalidArgument, ip)
+	}
+
+	// The subnet check must not run against cached details.
+	details, err := s.client.Network.Get(WithCacheBypass(ctx), networkURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := details.RequireRouterMode(); err != nil {
+		return nil, fmt.Errorf("reservation: create: %w", err)
+	}
+	if err := checkInLAN(addr, details.Lease.DHCP); err != nil {
+		return nil, fmt.Errorf("reservation: create: %w", err)
+	}
+
+	body := reservationRequest{MAC: mac, IP: addr.String(), Description: description}
+	req, err := s.client.newRequestFromURL(ctx, "reservation", http.MethodPost, networkURL+"/reservations", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EeroResponse[Reservation]
+	if err := s.client.doRaw(req, &resp); err != nil {
+		return nil, fmt.Errorf("reservation: create: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// Delete removes the reservation at reservationURL (the URL field of a
+// Reservation).
+func (s *ReservationService) Delete(ctx context.Context, reservationURL string) error {
+	req, err := s.client.newRequestFromURL(ctx, "reservation", http.MethodDelete, reservationURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.doRaw(req, nil); err != nil {
+		return fmt.Errorf("reservation: delete: %w", err)
+	}
+
+	return nil
+}
+
+// checkInLAN verifies that addr is a usable host address within the subnet
+// described by lease. A nil lease, or one without a parsable mask, skips the
+// check since the subnet is unknown.
+func checkInLAN(addr net.IP, lease *LeaseDHCP) error {
+	if lease == nil {
+		return nil
+	}
+	mask := net.IPMask(net.ParseIP(lease.Mask).To4())
+	base := net.ParseIP(lease.Router).To4()
+	if base == nil {
+		base = net.ParseIP(lease.IP).To4()
+	}
+	if base == nil || mask == nil {
+		return nil
+	}
+	if ones, bits := mask.Size(); bits == 0 || ones > 30 {
+		return nil
+	}
+
+	subnet := &net.IPNet{IP: base.Mask(mask), Mask: mask}
+	if !subnet.Contains(addr) {
+		return fmt.Errorf("%w: %s is outside the LAN subnet %s", ErrInvalidArgument, addr, subnet)
+	}
+
+	broadcast := make(net.IP, len(subnet.IP))
+	for i := range subnet.IP {
+		broadcast[i] = subnet.IP[i] | ^mask[i]
+	}
+	switch {
+	case addr.Equal(subnet.IP), addr.Equal(broadcast):
+		return fmt.Errorf("%w: %s is not a host address in %s", ErrInvalidArgument, addr, subnet)
+	case addr.Equal(net.ParseIP(lease.Router)):
+		return fmt.Errorf("%w: %s is the router address", ErrInvalidArgument, addr)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestReservationService_List(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/networks/55555/reservations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"meta": {"code": 200},
+			"data": [
+				{"url": "/2.2/networks/55555/reservations/1", "mac": "aa:bb:cc:dd:ee:01", "ip": "192.168.4.10", "description": "NAS"}
+			]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	reservations, err := client.Reservation.List(ctx, "/2.2/networks/55555")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(reservations) != 1 || reservations[0].IP != "192.168.4.10" || reservations[0].Description != "NAS" {
+		t.Errorf("Unexpected reservations: %+v", reservations)
+	}
+}
+
+func TestReservationService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		mac         string
+		ip          string
+		expectBody  string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:       "Success_InSubnet",
+			mac:        "aa:bb:cc:dd:ee:01",
+			ip:         "192.168.4.10",
+			expectBody: `{"mac":"aa:bb:cc:dd:ee:01","ip":"192.168.4.10","description":"NAS"}`,
+		},
+		{
+			name:        "Failure_OutsideSubnet",
+			mac:         "aa:bb:cc:dd:ee:01",
+			ip:          "10.0.0.5",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_RouterAddress",
+			mac:         "aa:bb:cc:dd:ee:01",
+			ip:          "192.168.4.1",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_Broadcast",
+			mac:         "aa:bb:cc:dd:ee:01",
+			ip:          "192.168.4.255",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_NotIPv4",
+			mac:         "aa:bb:cc:dd:ee:01",
+			ip:          "fe80::1",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Failure_BadMAC",
+			mac:         "not-a-mac",
+			ip:          "192.168.4.10",
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var posts int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/networks/55555", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"lease": {"mode": "dhcp", "dhcp": {"ip": "192.168.4.1", "mask": "255.255.255.0", "router": "192.168.4.1"}}}}`))
+			})
+			mux.HandleFunc("/2.2/networks/55555/reservations", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&posts, 1)
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectBody {
+					t.Errorf("Expected body %s, got %s", tc.expectBody, string(body))
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"url": "/2.2/networks/55555/reservations/9", "mac": "aa:bb:cc:dd:ee:01", "ip": "192.168.4.10", "description": "NAS"}}`))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			res, err := client.Reservation.Create(ctx, "/2.2/networks/55555", tc.mac, tc.ip, "NAS")
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantInvalid {
+				if !errors.Is(err, eero.ErrInvalidArgument) {
+					t.Errorf("Expected ErrInvalidArgument, got %v", err)
+				}
+				if n := atomic.LoadInt32(&posts); n != 0 {
+					t.Errorf("Expected no POST, got %d", n)
+				}
+				return
+			}
+			if res.URL != "/2.2/networks/55555/reservations/9" {
+				t.Errorf("Expected reservation URL, got %q", res.URL)
+			}
+		})
+	}
+}
+
+func TestReservationService_Delete(t *testing.T) {
+	t.Parallel()
+
+	reservationURL := "/2.2/networks/55555/reservations/9"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(reservationURL, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	if err := client.Reservation.Delete(context.Background(), reservationURL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package eero
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryDelay caps the computed backoff (and any Retry-After hint) so that a
+// misbehaving server cannot park a caller indefinitely.
+const maxRetryDelay = 30 * time.Second
+
+// retryConfig controls automatic retries of failed requests. The zero value
+// disables retries.
+type retryConfig struct {
+	// maxAttempts is the total number of attempts, including the first.
+	maxAttempts int
+	// baseDelay is the backoff before the first retry; it doubles after each
+	// subsequent attempt.
+	baseDelay time.Duration
+	// mutations allows non-idempotent methods (POST, PUT, DELETE, ...) to be
+	// retried as well.
+	mutations bool
+	// policy, when non-nil, replaces the default retryable classification
+	// (see WithRetryPolicy).
+	policy func(resp *http.Response, err error) bool
+}
+
+// WithRetry enables automatic retries for transient failures: network errors
+// and 5xx responses (except 501). maxAttempts is the total number of attempts
+// including the first, and baseDelay is the initial backoff, doubled (with
+// jitter) on every retry. A Retry-After response header, when present, takes
+// precedence over the computed backoff.
+//
+// Only idempotent requests (GET, HEAD, OPTIONS) are retried unless
+// WithRetryMutations is also supplied. 4xx responses are never retried, and
+// waiting between attempts stops as soon as the request context is done.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxAttempts < 1 {
+			return fmt.Errorf("WithRetry: maxAttempts must be at least 1, got %d", maxAttempts)
+		}
+		if baseDelay < 0 {
+			return fmt.Errorf("WithRetry: baseDelay must not be negative, got %s", baseDelay)
+		}
+		c.retry.maxAttempts = maxAttempts
+		c.retry.baseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithRetryMutations extends WithRetry to non-idempotent requests such as
+// NetworkService.Reboot or ProfileService.Pause. Only opt in when repeating
+// such a call is acceptable.
+func WithRetryMutations() Option {
+	return func(c *Client) error {
+		c.retry.mutations = true
+		return nil
+	}
+}
+
+// WithRetryPolicy replaces the default classification of which failed
+// attempts are retried (network errors and 5xx except 501) with policy. It
+// is called after every attempt with either the response or the transport
+// error, never both; the response body has already been consumed, so only
+// the status and headers are meaningful. Returning true schedules a retry.
+//
+// For example, a policy can retry 404s during the eventual-consistency
+// window after creating a resource. The policy only takes effect together
+// with WithRetry, which still bounds the number of attempts, and the
+// idempotency rule of WithRetryMutations still applies. Attempts that failed
+// because the request context is done are never retried.
+func WithRetryPolicy(policy func(resp *http.Response, err error) bool) Option {
+	return func(c *Client) error {
+		if policy == nil {
+			return errors.New("WithRetryPolicy: policy is nil")
+		}
+		c.retry.policy = policy
+		return nil
+	}
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating, applying
+// the caller's policy when one is configured.
+func (r retryConfig) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if r.policy != nil {
+		return r.policy(resp, err)
+	}
+	return retryable(ctx, resp, err)
+}
+
+// attemptsFor returns how many times req may be attempted in total.
+func (r retryConfig) attemptsFor(req *http.Request) int {
+	if r.maxAttempts <= 1 {
+		return 1
+	}
+	// A body that cannot be replayed rules out any retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 1
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return r.maxAttempts
+	}
+	if r.mutations {
+		return r.maxAttempts
+	}
+	return 1
+}
+
+// backoff returns how long to wait before the retry that follows the given
+// (1-based) attempt. A valid Retry-After header on resp overrides the
+// exponential schedule.
+func (r retryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(d, maxRetryDelay)
+		}
+	}
+	if r.baseDelay == 0 {
+		return 0
+	}
+	d := r.baseDelay << (attempt - 1)
+	if d <= 0 || d > maxRetryDelay {
+		d = maxRetryDelay
+	}
+	// Add up to 50% jitter so that concurrent clients do not retry in lockstep.
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryable reports whether a failed attempt is worth repeating.
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// Never retry once the caller has given up.
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// retryAfterOf returns the delay requested by resp's Retry-After header, or
+// zero when it is absent or invalid.
+func retryAfterOf(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	d, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header value, which may be either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewindRequest returns a copy of req whose body can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
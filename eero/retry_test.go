@@ -0,0 +1,184 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		opts         []eero.Option
+		failStatus   int
+		failures     int32
+		call         func(ctx context.Context, c *eero.Client) error
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{
+			name:       "Success_GetRetriedOn503",
+			opts:       []eero.Option{eero.WithRetry(3, time.Millisecond)},
+			failStatus: http.StatusServiceUnavailable,
+			failures:   2,
+			call: func(ctx context.Context, c *eero.Client) error {
+				_, err := c.Network.Get(ctx, "/2.2/networks/12345")
+				return err
+			},
+			wantAttempts: 3,
+		},
+		{
+			name:       "Failure_GetExhaustsAttempts",
+			opts:       []eero.Option{eero.WithRetry(2, time.Millisecond)},
+			failStatus: http.StatusBadGateway,
+			failures:   5,
+			call: func(ctx context.Context, c *eero.Client) error {
+				_, err := c.Network.Get(ctx, "/2.2/networks/12345")
+				return err
+			},
+			wantErr:      true,
+			wantAttempts: 2,
+		},
+		{
+			name:       "Failure_4xxNeverRetried",
+			opts:       []eero.Option{eero.WithRetry(3, time.Millisecond)},
+			failStatus: http.StatusNotFound,
+			failures:   5,
+			call: func(ctx context.Context, c *eero.Client) error {
+				_, err := c.Network.Get(ctx, "/2.2/networks/12345")
+				return err
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "Success_CustomPolicyRetries404",
+			opts: []eero.Option{
+				eero.WithRetry(3, time.Millisecond),
+				eero.WithRetryPolicy(func(resp *http.Response, err error) bool {
+					return err == nil && resp.StatusCode == http.StatusNotFound
+				}),
+			},
+			failStatus: http.StatusNotFound,
+			failures:   2,
+			call: func(ctx context.Context, c *eero.Client) error {
+				_, err := c.Network.Get(ctx, "/2.2/networks/12345")
+				return err
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "Failure_CustomPolicyOverridesDefault5xx",
+			opts: []eero.Option{
+				eero.WithRetry(3, time.Millisecond),
+				eero.WithRetryPolicy(func(resp *http.Response, err error) bool {
+					return err == nil && resp.StatusCode == http.StatusNotFound
+				}),
+			},
+			failStatus: http.StatusServiceUnavailable,
+			failures:   1,
+			call: func(ctx context.Context, c *eero.Client) error {
+				_, err := c.Network.Get(ctx, "/2.2/networks/12345")
+				return err
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:       "Failure_RebootNotRetriedByDefault",
+			opts:       []eero.Option{eero.WithRetry(3, time.Millisecond)},
+			failStatus: http.StatusServiceUnavailable,
+			failures:   1,
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.Reboot(ctx, "/2.2/networks/12345")
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:       "Success_RebootRetriedWhenOptedIn",
+			opts:       []eero.Option{eero.WithRetry(3, time.Millisecond), eero.WithRetryMutations()},
+			failStatus: http.StatusServiceUnavailable,
+			failures:   1,
+			call: func(ctx context.Context, c *eero.Client) error {
+				return c.Network.Reboot(ctx, "/2.2/networks/12345")
+			},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= tc.failures {
+					w.WriteHeader(tc.failStatus)
+					_, _ = w.Write([]byte(`{"meta": {"code": 0, "error": "transient"}, "data": {}}`))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"name": "Home Mesh"}}`))
+			}))
+			defer server.Close()
+
+			client, err := eero.NewClient(tc.opts...)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			client.BaseURL = server.URL + "/2.2"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			err = tc.call(ctx, client)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got := atomic.LoadInt32(&attempts); got != tc.wantAttempts {
+				t.Errorf("Expected %d attempts, got %d", tc.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestWithRetry_HonorsRetryAfterAndContext(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"meta": {"code": 503}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient(eero.WithRetry(3, time.Millisecond))
+	client.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Account.Get(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded while waiting for Retry-After, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected retry wait to stop at the context deadline, took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("Expected a single attempt before the deadline, got %d", got)
+	}
+}
@@ -0,0 +1,200 @@
+package eero
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxSessionTokenLen is a generous upper bound on the size of an eero
+// user_token. Real tokens are far shorter; anything beyond this is almost
+// certainly a corrupted cache file rather than a credential.
+const maxSessionTokenLen = 4096
+
+// ErrInvalidSessionToken is returned when a session token is empty or
+// cannot possibly be a valid eero user_token.
+var ErrInvalidSessionToken = errors.New("eero: invalid session token")
+
+// ErrSessionNotSaved is returned when authentication succeeded but the
+// session token could not be written to the SessionStore. The error also
+// wraps the store's error. The client itself is authenticated and usable, so
+// callers can treat this as a warning; only the next run will have to log in
+// again.
+var ErrSessionNotSaved = errors.New("eero: session authenticated but not saved")
+
+// SessionStore persists an eero session token between program runs.
+// Implementations must be safe to call from the goroutine driving
+// authentication; the client never calls them concurrently.
+type SessionStore interface {
+	// Load returns the previously saved token.
+	Load() (string, error)
+	// Save persists token, replacing any previously saved value.
+	Save(token string) error
+}
+
+// FileSessionStore is a SessionStore that keeps the token in a JSON file of
+// the form {"user_token": "..."}, the format used by the example program.
+// The file is written with 0600 permissions so only its owner can read it.
+type FileSessionStore struct {
+	Path string
+}
+
+// fileSession is the on-disk format of a FileSessionStore.
+type fileSession struct {
+	UserToken string `json:"user_token"`
+}
+
+// Load reads the token from the file. A missing file yields an error
+// matching fs.ErrNotExist; unparsable contents yield one matching
+// ErrInvalidSessionToken.
+func (s *FileSessionStore) Load() (string, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", err
+	}
+	var sess fileSession
+	if err := json.Unmarshal(data, &sess); err != nil {
+		return "", fmt.Errorf("%w: parsing %s: %v", ErrInvalidSessionToken, s.Path, err)
+	}
+	return sess.UserToken, nil
+}
+
+// Save writes token to the file with 0600 permissions. The file is replaced
+// atomically, so a crash mid-write never leaves a truncated session behind.
+func (s *FileSessionStore) Save(token string) error {
+	data, err := json.MarshalIndent(fileSession{UserToken: token}, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }() // no-op once renamed
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// restoreSession loads the token from the client's session store and sets
+// it as the session cookie. A missing or invalid saved token is not an
+// error: the client simply starts unauthenticated.
+func (c *Client) restoreSession() error {
+	token, err := c.sessionStore.Load()
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, ErrInvalidSessionToken) {
+			return nil
+		}
+		return fmt.Errorf("eero: loading session: %w", err)
+	}
+	if ValidateSessionToken(token) != nil {
+		return nil
+	}
+	return c.SetSessionCookie(token)
+}
+
+// saveSession persists the current session token to the client's session
+// store, if any.
+func (c *Client) saveSession() error {
+	if c.sessionStore == nil {
+		return nil
+	}
+	c.sessionMu.Lock()
+	token := c.sessionToken
+	c.sessionMu.Unlock()
+	if token == "" {
+		return nil
+	}
+	return c.sessionStore.Save(token)
+}
+
+// ValidateSessionToken reports whether token is plausibly a valid eero
+// user_token. It does not contact the API; it only rejects values that are
+// empty, padded with whitespace, oversized, or contain bytes that are not
+// permitted in a cookie value (RFC 6265). Use it to catch corrupted session
+// caches before injecting them with SetSessionCookie.
+func ValidateSessionToken(token string) error {
+	if token == "" {
+		return fmt.Errorf("%w: token is empty", ErrInvalidSessionToken)
+	}
+	if strings.TrimSpace(token) == "" {
+		return fmt.Errorf("%w: token is only whitespace", ErrInvalidSessionToken)
+	}
+	if strings.TrimSpace(token) != token {
+		return fmt.Errorf("%w: token has leading or trailing whitespace", ErrInvalidSessionToken)
+	}
+	if len(token) > maxSessionTokenLen {
+		return fmt.Errorf("%w: token exceeds %d bytes", ErrInvalidSessionToken, maxSessionTokenLen)
+	}
+	for i := 0; i < len(token); i++ {
+		if !validCookieValueByte(token[i]) {
+			return fmt.Errorf("%w: token contains invalid character at offset %d", ErrInvalidSessionToken, i)
+		}
+	}
+	return nil
+}
+
+// validCookieValueByte reports whether b is a legal cookie-octet as defined
+// by RFC 6265 section 4.1.1.
+func validCookieValueByte(b byte) bool {
+	return 0x20 < b && b < 0x7f && b != '"' && b != ',' && b != ';' && b != '\\'
+}
+
+// AuthenticateInteractive runs the complete two-step login flow and persists
+// the resulting session token:
+//
+//  1. Auth.Login is called with identifier (email or phone).
+//  2. codeFn is invoked to obtain the verification code, typically by
+//     prompting the user.
+//  3. Auth.Verify submits the code.
+//  4. The token is saved to store, if store is non-nil.
+//
+// The token is only persisted once verification succeeds, so a mistyped code
+// never leaves an unverified token behind in the store. If the client's own
+// WithSessionStore store fails to save the token, store is still written; an
+// error wrapping ErrSessionNotSaved is returned only when the token ends up
+// in neither.
+func (c *Client) AuthenticateInteractive(ctx context.Context, identifier string, codeFn func() (string, error), store SessionStore) error {
+	if codeFn == nil {
+		return errors.New("auth: verification code callback is nil")
+	}
+
+	login, err := c.Auth.Login(ctx, identifier)
+	if err != nil {
+		return fmt.Errorf("auth: login: %w", err)
+	}
+
+	code, err := codeFn()
+	if err != nil {
+		return fmt.Errorf("auth: reading verification code: %w", err)
+	}
+
+	verifyErr := c.Auth.Verify(ctx, strings.TrimSpace(code))
+	if verifyErr != nil && !errors.Is(verifyErr, ErrSessionNotSaved) {
+		return fmt.Errorf("auth: verify: %w", verifyErr)
+	}
+
+	// The session is active even if the client's store failed, so the
+	// explicit store still gets the token and its result decides.
+	if store == nil {
+		return verifyErr
+	}
+	if err := store.Save(login.UserToken); err != nil {
+		return fmt.Errorf("auth: saving session: %w: %w", ErrSessionNotSaved, err)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package eero_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestValidateSessionToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:    "Success_ValidToken",
+			token:   "test-session-token-123",
+			wantErr: false,
+		},
+		{
+			name:    "Failure_Empty",
+			token:   "",
+			wantErr: true,
+		},
+		{
+			name:    "Failure_OnlyWhitespace",
+			token:   "   \t\n",
+			wantErr: true,
+		},
+		{
+			name:    "Failure_TrailingNewline",
+			token:   "token_12345\n",
+			wantErr: true,
+		},
+		{
+			name:    "Failure_CookieSeparator",
+			token:   "token;other=1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := eero.ValidateSessionToken(tc.token)
+			if !tc.wantErr {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if !errors.Is(err, eero.ErrInvalidSessionToken) {
+				t.Errorf("Expected errors.Is(err, ErrInvalidSessionToken), got %v", err)
+			}
+		})
+	}
+}
+
+func TestSetSessionCookie_RejectsInvalidToken(t *testing.T) {
+	t.Parallel()
+
+	client, err := eero.NewClient()
+	if err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	if err := client.SetSessionCookie("  "); !errors.Is(err, eero.ErrInvalidSessionToken) {
+		t.Fatalf("Expected ErrInvalidSessionToken, got %v", err)
+	}
+}
+
+// memoryStore is an in-memory eero.SessionStore used to observe persistence.
+type memoryStore struct {
+	token string
+	saves int
+}
+
+func (m *memoryStore) Load() (string, error) { return m.token, nil }
+
+func (m *memoryStore) Save(token string) error {
+	m.token = token
+	m.saves++
+	return nil
+}
+
+func TestClient_AuthenticateInteractive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		verifyStatus int
+		verifyBody   string
+		wantErr      bool
+		expectToken  string
+	}{
+		{
+			name:         "Success_PersistsToken",
+			verifyStatus: http.StatusOK,
+			verifyBody:   `{"meta": {"code": 200}, "data": {}}`,
+			expectToken:  "token_12345",
+		},
+		{
+			name:         "Failure_VerifyRejectedDoesNotPersist",
+			verifyStatus: http.StatusForbidden,
+			verifyBody:   `{"meta": {"code": 403, "error": "Invalid verification code"}, "data": {}}`,
+			wantErr:      true,
+			expectToken:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "token_12345"}}`))
+			})
+			mux.HandleFunc("/login/verify", func(w http.ResponseWriter, r *http.Request) {
+				var body eero.VerifyRequest
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if body.Code != "123456" {
+					t.Errorf("Expected trimmed code %q, got %q", "123456", body.Code)
+				}
+				w.WriteHeader(tc.verifyStatus)
+				_, _ = w.Write([]byte(tc.verifyBody))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			store := &memoryStore{}
+			codeFn := func() (string, error) { return "123456\n", nil }
+
+			err := client.AuthenticateInteractive(ctx, "test@example.com", codeFn, store)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("AuthenticateInteractive() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if store.token != tc.expectToken {
+				t.Errorf("Expected stored token %q, got %q", tc.expectToken, store.token)
+			}
+			if tc.wantErr && store.saves != 0 {
+				t.Errorf("Expected no saves on failure, got %d", store.saves)
+			}
+		})
+	}
+}
+
+func TestClient_AuthenticateInteractive_AmazonLoginRequired(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"meta": {"code": 403, "error": "This account must use Amazon login"}, "data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL
+
+	codeFn := func() (string, error) {
+		t.Error("codeFn must not be called when Login fails")
+		return "", nil
+	}
+	err := client.AuthenticateInteractive(context.Background(), "linked@example.com", codeFn, nil)
+	if !errors.Is(err, eero.ErrAmazonLoginRequired) {
+		t.Fatalf("AuthenticateInteractive() error = %v, want ErrAmazonLoginRequired", err)
+	}
+	if n := strings.Count(err.Error(), "auth: login:"); n != 1 {
+		t.Errorf("Expected the \"auth: login:\" prefix once, got %d in %q", n, err)
+	}
+}
+
+func TestFileSessionStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	store := &eero.FileSessionStore{Path: path}
+
+	if _, err := store.Load(); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected fs.ErrNotExist for a missing file, got %v", err)
+	}
+
+	if err := store.Save("token_12345"); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected 0600 permissions, got %o", info.Mode().Perm())
+	}
+	data, _ := os.ReadFile(path)
+	var sess struct {
+		UserToken string `json:"user_token"`
+	}
+	if err := json.Unmarshal(data, &sess); err != nil || sess.UserToken != "token_12345" {
+		t.Errorf("Expected {\"user_token\": \"token_12345\"}, got %s", data)
+	}
+
+	token, err := store.Load()
+	if err != nil || token != "token_12345" {
+		t.Errorf("Load() = %q, %v; want token_12345", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load(); !errors.Is(err, eero.ErrInvalidSessionToken) {
+		t.Errorf("Expected ErrInvalidSessionToken for a corrupted file, got %v", err)
+	}
+}
+
+// failingStore is an eero.SessionStore whose Load always fails.
+type failingStore struct{ err error }
+
+func (f failingStore) Load() (string, error) { return "", f.err }
+func (f failingStore) Save(string) error     { return f.err }
+
+// saveFailingStore is an eero.SessionStore that loads nothing and fails
+// every Save.
+type saveFailingStore struct{ err error }
+
+func (s saveFailingStore) Load() (string, error) { return "", nil }
+func (s saveFailingStore) Save(string) error     { return s.err }
+
+func TestWithSessionStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RestoresOnConstruction", func(t *testing.T) {
+		t.Parallel()
+		client, err := eero.NewClient(eero.WithSessionStore(&memoryStore{token: "saved_token"}))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		cookie, ok := client.SessionCookie()
+		if !ok || cookie.Value != "saved_token" {
+			t.Errorf("Expected restored cookie saved_token, got %+v (ok=%v)", cookie, ok)
+		}
+	})
+
+	t.Run("MissingOrInvalidTokenStartsEmpty", func(t *testing.T) {
+		t.Parallel()
+		stores := []eero.SessionStore{
+			&eero.FileSessionStore{Path: filepath.Join(t.TempDir(), "absent.json")},
+			&memoryStore{token: " padded "},
+		}
+		for _, store := range stores {
+			client, err := eero.NewClient(eero.WithSessionStore(store))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if _, ok := client.SessionCookie(); ok {
+				t.Errorf("Expected no session cookie for %T", store)
+			}
+		}
+	})
+
+	t.Run("LoadErrorFailsConstruction", func(t *testing.T) {
+		t.Parallel()
+		boom := errors.New("permission denied")
+		if _, err := eero.NewClient(eero.WithSessionStore(failingStore{err: boom})); !errors.Is(err, boom) {
+			t.Errorf("Expected NewClient to surface the load error, got %v", err)
+		}
+		if _, err := eero.NewClient(eero.WithSessionStore(nil)); err == nil {
+			t.Error("Expected an error for a nil store")
+		}
+	})
+
+	t.Run("SavesAfterVerify", func(t *testing.T) {
+		t.Parallel()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "token_12345"}}`))
+		})
+		mux.HandleFunc("/login/verify", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		store := &memoryStore{}
+		client, err := eero.NewClient(eero.WithBaseURL(server.URL), eero.WithSessionStore(store))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+
+		ctx := context.Background()
+		if _, err := client.Auth.Login(ctx, "test@example.com"); err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		if store.saves != 0 {
+			t.Errorf("Expected no save before verification, got %d", store.saves)
+		}
+		if err := client.Auth.Verify(ctx, "123456"); err != nil {
+			t.Fatalf("Verify() error = %v", err)
+		}
+		if store.token != "token_12345" || store.saves != 1 {
+			t.Errorf("Expected token_12345 saved once, got %q (%d saves)", store.token, store.saves)
+		}
+	})
+	t.Run("SaveErrorIsNonFatal", func(t *testing.T) {
+		t.Parallel()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "token_12345"}}`))
+		})
+		mux.HandleFunc("/login/verify", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		boom := errors.New("disk full")
+		client, err := eero.NewClient(eero.WithBaseURL(server.URL), eero.WithSessionStore(saveFailingStore{err: boom}))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+
+		ctx := context.Background()
+		if _, err := client.Auth.Login(ctx, "test@example.com"); err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		err = client.Auth.Verify(ctx, "123456")
+		if !errors.Is(err, eero.ErrSessionNotSaved) || !errors.Is(err, boom) {
+			t.Fatalf("Verify() error = %v, want ErrSessionNotSaved wrapping the store error", err)
+		}
+		if cookie, ok := client.SessionCookie(); !ok || cookie.Value != "token_12345" {
+			t.Errorf("Expected the session to stay active, got %+v (ok=%v)", cookie, ok)
+		}
+	})
+	t.Run("SaveErrorStillWritesExplicitStore", func(t *testing.T) {
+		t.Parallel()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"user_token": "token_12345"}}`))
+		})
+		mux.HandleFunc("/login/verify", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {}}`))
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, err := eero.NewClient(eero.WithBaseURL(server.URL), eero.WithSessionStore(saveFailingStore{err: errors.New("disk full")}))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+
+		store := &memoryStore{}
+		codeFn := func() (string, error) { return "123456", nil }
+		if err := client.AuthenticateInteractive(context.Background(), "test@example.com", codeFn, store); err != nil {
+			t.Fatalf("AuthenticateInteractive() error = %v, want nil once the explicit store saved", err)
+		}
+		if store.token != "token_12345" || store.saves != 1 {
+			t.Errorf("Expected token_12345 saved once to the explicit store, got %q (%d saves)", store.token, store.saves)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package eero
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// maxSnapshotConcurrency bounds the number of requests Snapshot has in
+// flight at once.
+const maxSnapshotConcurrency = 5
+
+// Snapshot is a point-in-time view of an account: the account itself plus
+// the details and devices of each of its networks.
+type Snapshot struct {
+	Account  *Account
+	Networks []NetworkSnapshot // in Account.Networks.Data order
+}
+
+// NetworkSnapshot holds one network's details and device list.
+type NetworkSnapshot struct {
+	URL     string
+	Details *NetworkDetails
+	Devices []Device
+}
+
+// Snapshot fetches the account and then, concurrently, NetworkService.Get
+// and DeviceService.List for every network on it, with at most five
+// requests in flight. The first failure cancels the outstanding requests
+// and is returned; no partial snapshot is returned alongside it.
+func (c *Client) Snapshot(ctx context.Context) (*Snapshot, error) {
+	acct, err := c.Account.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	snap := &Snapshot{
+		Account:  acct,
+		Networks: make([]NetworkSnapshot, len(acct.Networks.Data)),
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, maxSnapshotConcurrency)
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = fmt.Errorf("snapshot: %w", err)
+			cancel()
+		})
+	}
+	// run executes fn once a concurrency slot is free, unless ctx is
+	// cancelled first.
+	run := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			}
+			defer func() { <-sem }()
+			if err := fn(); err != nil {
+				fail(err)
+			}
+		}()
+	}
+
+	for i, n := range acct.Networks.Data {
+		ns := &snap.Networks[i]
+		ns.URL = n.URL
+		run(func() (err error) {
+			ns.Details, err = c.Network.Get(ctx, ns.URL)
+			return err
+		})
+		run(func() (err error) {
+			ns.Devices, err = c.Device.List(ctx, ns.URL)
+			return err
+		})
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return snap, nil
+}
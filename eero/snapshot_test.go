@@ -0,0 +1,178 @@
+package eero_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arvarik/eero-go/eero"
+)
+
+func TestClient_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		failDevice string // network ID whose device list fails
+		wantErr    bool
+	}{
+		{name: "Success_AllNetworks"},
+		{name: "Failure_DeviceListError", failDevice: "3", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			const networks = 6
+			var inFlight, maxInFlight int32
+			track := func() func() {
+				n := atomic.AddInt32(&inFlight, 1)
+				for {
+					m := atomic.LoadInt32(&maxInFlight)
+					if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return func() { atomic.AddInt32(&inFlight, -1) }
+			}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+				var refs []string
+				for i := 1; i <= networks; i++ {
+					refs = append(refs, fmt.Sprintf(`{"url": "/2.2/networks/%d", "name": "net%d"}`, i, i))
+				}
+				fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"networks": {"count": %d, "data": [%s]}}}`, networks, strings.Join(refs, ","))
+			})
+			mux.HandleFunc("/2.2/networks/", func(w http.ResponseWriter, r *http.Request) {
+				defer track()()
+				parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/2.2/networks/"), "/")
+				id := parts[0]
+				if len(parts) == 2 && parts[1] == "devices" {
+					if id == tc.failDevice {
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"meta": {"code": 500, "error": "boom"}}`))
+						return
+					}
+					fmt.Fprintf(w, `{"meta": {"code": 200}, "data": [{"mac": "aa:bb:cc:dd:ee:0%s"}]}`, id)
+					return
+				}
+				fmt.Fprintf(w, `{"meta": {"code": 200}, "data": {"url": "/2.2/networks/%s", "name": "net%s"}}`, id, id)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, _ := eero.NewClient()
+			client.BaseURL = server.URL + "/2.2"
+
+			snap, err := client.Snapshot(context.Background())
+			if got := atomic.LoadInt32(&maxInFlight); got > 5 {
+				t.Errorf("Expected at most 5 concurrent requests, saw %d", got)
+			}
+			if tc.wantErr {
+				var apiErr *eero.APIError
+				if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != http.StatusInternalServerError {
+					t.Fatalf("Expected the 500 *APIError, got %v", err)
+				}
+				if snap != nil {
+					t.Errorf("Expected no snapshot on error, got %+v", snap)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Snapshot() error = %v", err)
+			}
+			if len(snap.Networks) != networks {
+				t.Fatalf("Expected %d networks, got %d", networks, len(snap.Networks))
+			}
+			for i, ns := range snap.Networks {
+				wantURL := fmt.Sprintf("/2.2/networks/%d", i+1)
+				if ns.URL != wantURL || ns.Details == nil || ns.Details.URL != wantURL {
+					t.Errorf("Network %d: unexpected snapshot %+v", i, ns)
+				}
+				if len(ns.Devices) != 1 || ns.Devices[0].MAC != fmt.Sprintf("aa:bb:cc:dd:ee:0%d", i+1) {
+					t.Errorf("Network %d: unexpected devices %+v", i, ns.Devices)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_Snapshot_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.2/account", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"meta": {"code": 200}, "data": {"networks": {"count": 1, "data": [{"url": "/2.2/networks/1"}]}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := eero.NewClient()
+	client.BaseURL = server.URL + "/2.2"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Snapshot(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// Partial fakes: embedding the interface satisfies it, and only the methods
+// Snapshot calls are implemented.
+type fakeAccountAPI struct {
+	eero.AccountAPI
+	account *eero.Account
+}
+
+func (f fakeAccountAPI) Get(ctx context.Context) (*eero.Account, error) {
+	return f.account, nil
+}
+
+type fakeNetworkAPI struct{ eero.NetworkAPI }
+
+func (fakeNetworkAPI) Get(ctx context.Context, networkURL string) (*eero.NetworkDetails, error) {
+	return &eero.NetworkDetails{Name: "Fake " + networkURL}, nil
+}
+
+type fakeDeviceAPI struct{ eero.DeviceAPI }
+
+func (fakeDeviceAPI) List(ctx context.Context, networkURL string, opts ...eero.ListOption) ([]eero.Device, error) {
+	return []eero.Device{{MAC: "aa:bb:cc:dd:ee:ff"}}, nil
+}
+
+func TestClient_Snapshot_Fakes(t *testing.T) {
+	t.Parallel()
+
+	// No server: every request the services would send goes to a fake.
+	client, err := eero.NewClient(eero.WithBaseURL("https://fake.invalid/2.2"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.Account = fakeAccountAPI{account: &eero.Account{
+		Networks: eero.AccountNetworks{Data: []eero.NetworkSummary{{URL: "/2.2/networks/1"}}},
+	}}
+	client.Network = fakeNetworkAPI{}
+	client.Device = fakeDeviceAPI{}
+
+	snap, err := client.Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if len(snap.Networks) != 1 {
+		t.Fatalf("Expected 1 network, got %d", len(snap.Networks))
+	}
+	ns := snap.Networks[0]
+	if ns.Details.Name != "Fake /2.2/networks/1" || len(ns.Devices) != 1 || ns.Devices[0].MAC != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("Snapshot network = %+v, want the fakes' data", ns)
+	}
+}
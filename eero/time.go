@@ -3,17 +3,60 @@ package eero
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // EeroTime handles eero's custom timestamp formats that do not strictly comply
 // with RFC3339, such as "2006-01-02T15:04:05+0000".
-// It will try to parse using this custom format first, and fallback to
-// time.RFC3339 format if it fails.
+// It tries each accepted layout in order: the custom format first, then
+// time.RFC3339, then any registered with RegisterEeroTimeLayout.
 type EeroTime struct {
 	time.Time
 }
 
+// defaultEeroTimeLayouts are the layouts EeroTime always accepts, in order.
+var defaultEeroTimeLayouts = []string{"2006-01-02T15:04:05Z0700", time.RFC3339}
+
+var (
+	// eeroTimeLayoutsMu serializes RegisterEeroTimeLayout.
+	eeroTimeLayoutsMu sync.Mutex
+	// eeroTimeLayouts holds the current layout list. It is replaced, never
+	// modified in place, so UnmarshalJSON can read it without locking.
+	eeroTimeLayouts atomic.Pointer[[]string]
+)
+
+func init() {
+	layouts := append([]string(nil), defaultEeroTimeLayouts...)
+	eeroTimeLayouts.Store(&layouts)
+}
+
+// RegisterEeroTimeLayout appends layout (in time.Parse syntax) to the list
+// EeroTime tries when decoding, after the defaults and any layouts
+// registered earlier. Registering a layout that is already accepted has no
+// effect. It is safe for concurrent use, but is typically called from an
+// init function before any decoding happens.
+func RegisterEeroTimeLayout(layout string) {
+	eeroTimeLayoutsMu.Lock()
+	defer eeroTimeLayoutsMu.Unlock()
+	current := *eeroTimeLayouts.Load()
+	for _, l := range current {
+		if l == layout {
+			return
+		}
+	}
+	next := append(append(make([]string, 0, len(current)+1), current...), layout)
+	eeroTimeLayouts.Store(&next)
+}
+
+// EeroTimeLayouts returns a copy of the layouts EeroTime currently accepts,
+// in the order they are tried.
+func EeroTimeLayouts() []string {
+	return append([]string(nil), *eeroTimeLayouts.Load()...)
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (t *EeroTime) UnmarshalJSON(b []byte) error {
 	// 1. Handle explicit nulls safely
@@ -38,15 +81,26 @@ func (t *EeroTime) UnmarshalJSON(b []byte) error {
 		return nil
 	}
 
-	// 4. Attempt parsing
-	parsed, err := time.Parse("2006-01-02T15:04:05Z0700", s)
-	if err != nil {
-		// Fallback to strict format
-		parsed, err = time.Parse(time.RFC3339, s)
-		if err != nil {
-			return err
+	// 4. Attempt parsing with each layout in order. time.Parse accepts a
+	// fractional second after the seconds field even though the layout has
+	// none, so this also covers millisecond timestamps such as
+	// "2006-01-02T15:04:05.249+0000".
+	for _, layout := range *eeroTimeLayouts.Load() {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
 		}
 	}
-	t.Time = parsed
-	return nil
+	return fmt.Errorf("eero: unrecognized time %q (accepted layouts: %q)", s, *eeroTimeLayouts.Load())
+}
+
+// MarshalJSON implements the json.Marshaler interface. It emits RFC3339
+// (with fractional seconds when present), which UnmarshalJSON accepts, so
+// decoded values round-trip through JSON. The zero time encodes as null,
+// mirroring how null and "" decode.
+func (t EeroTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.Format(time.RFC3339Nano) + `"`), nil
 }
@@ -2,6 +2,7 @@ package eero_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,30 +17,57 @@ func TestEeroTime_UnmarshalJSON(t *testing.T) {
 		payload  string
 		wantErr  bool
 		expected time.Time
+		// marshaled is the JSON that re-encoding the decoded value produces.
+		marshaled string
 	}{
 		{
-			name:     "Success_EeroCustomFormat",
-			payload:  `"2026-02-21T22:14:52+0000"`,
-			wantErr:  false,
-			expected: time.Date(2026, time.February, 21, 22, 14, 52, 0, time.UTC),
+			name:      "Success_EeroCustomFormat",
+			payload:   `"2026-02-21T22:14:52+0000"`,
+			wantErr:   false,
+			expected:  time.Date(2026, time.February, 21, 22, 14, 52, 0, time.UTC),
+			marshaled: `"2026-02-21T22:14:52Z"`,
 		},
 		{
-			name:     "Success_RFC3339Format",
-			payload:  `"2026-02-21T22:14:52Z"`,
-			wantErr:  false,
-			expected: time.Date(2026, time.February, 21, 22, 14, 52, 0, time.UTC),
+			name:      "Success_RFC3339Format",
+			payload:   `"2026-02-21T22:14:52Z"`,
+			wantErr:   false,
+			expected:  time.Date(2026, time.February, 21, 22, 14, 52, 0, time.UTC),
+			marshaled: `"2026-02-21T22:14:52Z"`,
 		},
 		{
-			name:     "Success_Null",
-			payload:  `null`,
-			wantErr:  false,
-			expected: time.Time{},
+			name:      "Success_FractionalCustomOffset",
+			payload:   `"2026-02-21T22:14:52.249+0000"`,
+			wantErr:   false,
+			expected:  time.Date(2026, time.February, 21, 22, 14, 52, 249000000, time.UTC),
+			marshaled: `"2026-02-21T22:14:52.249Z"`,
 		},
 		{
-			name:     "Success_EmptyString",
-			payload:  `""`,
-			wantErr:  false,
-			expected: time.Time{},
+			name:      "Success_FractionalZulu",
+			payload:   `"2026-02-21T22:14:52.249Z"`,
+			wantErr:   false,
+			expected:  time.Date(2026, time.February, 21, 22, 14, 52, 249000000, time.UTC),
+			marshaled: `"2026-02-21T22:14:52.249Z"`,
+		},
+		{
+			name:      "Success_OffsetAndFraction",
+			payload:   `"2026-02-21T14:14:52.5-08:00"`,
+			wantErr:   false,
+			expected:  time.Date(2026, time.February, 21, 22, 14, 52, 500000000, time.UTC),
+			marshaled: `"2026-02-21T14:14:52.5-08:00"`,
+		},
+		{
+			name:      "Success_Null",
+			payload:   `null`,
+			wantErr:   false,
+			expected:  time.Time{},
+			marshaled: `null`,
+		},
+		{
+			name:      "Success_EmptyString",
+			payload:   `""`,
+			wantErr:   false,
+			expected:  time.Time{},
+			marshaled: `null`,
 		},
 		{
 			name:    "Failure_InvalidString",
@@ -70,6 +98,62 @@ func TestEeroTime_UnmarshalJSON(t *testing.T) {
 			if !et.Equal(tc.expected) {
 				t.Fatalf("Time parsed incorrectly. Wanted %s, got %s", tc.expected.Format(time.RFC3339), et.Format(time.RFC3339))
 			}
+
+			// Marshal direction: both the value and a pointer encode the same
+			// way, and the output decodes back to the same instant.
+			for _, v := range []any{et, &et} {
+				out, err := json.Marshal(v)
+				if err != nil {
+					t.Fatalf("Unexpected marshal error: %v", err)
+				}
+				if string(out) != tc.marshaled {
+					t.Errorf("Marshaled %T to %s, want %s", v, out, tc.marshaled)
+				}
+			}
+			var back eero.EeroTime
+			out, _ := json.Marshal(et)
+			if err := json.Unmarshal(out, &back); err != nil || !back.Equal(et.Time) {
+				t.Errorf("Round trip of %s gave %s (err %v)", out, back.Format(time.RFC3339Nano), err)
+			}
 		})
 	}
 }
+
+func TestRegisterEeroTimeLayout(t *testing.T) {
+	// Not parallel: it extends the package-wide layout list.
+	const layout = "02/01/2006 15:04"
+	payload := []byte(`"21/02/2026 22:14"`)
+
+	var et eero.EeroTime
+	err := json.Unmarshal(payload, &et)
+	if err == nil {
+		t.Fatal("Expected an error before the layout is registered")
+	}
+	if !strings.Contains(err.Error(), "21/02/2026 22:14") {
+		t.Errorf("Expected the error to name the offending value, got %v", err)
+	}
+
+	before := len(eero.EeroTimeLayouts())
+	eero.RegisterEeroTimeLayout(layout)
+	eero.RegisterEeroTimeLayout(layout)
+	layouts := eero.EeroTimeLayouts()
+	if len(layouts) != before+1 || layouts[len(layouts)-1] != layout {
+		t.Errorf("Expected %q appended once, got %q", layout, layouts)
+	}
+	if layouts[0] != "2006-01-02T15:04:05Z0700" || layouts[1] != time.RFC3339 {
+		t.Errorf("Expected the default layouts first, got %q", layouts)
+	}
+
+	if err := json.Unmarshal(payload, &et); err != nil {
+		t.Fatalf("Unexpected error after registering the layout: %v", err)
+	}
+	if want := time.Date(2026, time.February, 21, 22, 14, 0, 0, time.UTC); !et.Equal(want) {
+		t.Errorf("Expected %s, got %s", want, et.Time)
+	}
+
+	// Mutating the returned slice must not affect decoding.
+	layouts[0] = "garbage"
+	if err := json.Unmarshal([]byte(`"2026-02-21T22:14:52+0000"`), &et); err != nil {
+		t.Errorf("Expected defaults to be unaffected by callers, got %v", err)
+	}
+}
@@ -0,0 +1,10 @@
+package eero
+
+// Version is the version of this library. It is advertised in the
+// User-Agent suffix (see WithUserAgentSuffix) so that library-originated
+// traffic can be told apart from the official app.
+const Version = "0.1.0"
+
+// defaultUserAgentSuffix is appended to the User-Agent unless overridden
+// with WithUserAgentSuffix.
+const defaultUserAgentSuffix = "eero-go/" + Version